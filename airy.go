@@ -0,0 +1,204 @@
+package special
+
+import "math"
+
+// AiryAi returns the Airy function of the first kind, which is the solution of
+//
+//	y''(x) = x y(x)
+//
+// that decays as x -> +∞, normalised such that
+//
+//	AiryAi(0) = 1 / [3**(2/3) Gamma(2/3)]
+//
+// See http://mathworld.wolfram.com/AiryFunctions.html for more information.
+func AiryAi(x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case math.IsInf(x, 0):
+		return 0
+	}
+	ai, _, _, _ := airy(x)
+	return ai
+}
+
+// AiryAiPrime returns the derivative of the Airy function of the first kind.
+//
+// See http://mathworld.wolfram.com/AiryFunctions.html for more information.
+func AiryAiPrime(x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, -1):
+		return math.NaN()
+	case math.IsInf(x, 1):
+		return 0
+	}
+	_, aip, _, _ := airy(x)
+	return aip
+}
+
+// AiryBi returns the Airy function of the second kind, which is the solution of
+//
+//	y''(x) = x y(x)
+//
+// that has the same amplitude of oscillation as AiryAi as x -> -∞, normalised such that
+//
+//	AiryBi(0) = 1 / [3**(1/6) Gamma(2/3)]
+//
+// See http://mathworld.wolfram.com/AiryFunctions.html for more information.
+func AiryBi(x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case math.IsInf(x, 1):
+		return x
+	case math.IsInf(x, -1):
+		return 0
+	}
+	_, _, bi, _ := airy(x)
+	return bi
+}
+
+// AiryBiPrime returns the derivative of the Airy function of the second kind.
+//
+// See http://mathworld.wolfram.com/AiryFunctions.html for more information.
+func AiryBiPrime(x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, -1):
+		return math.NaN()
+	case math.IsInf(x, 1):
+		return x
+	}
+	_, _, _, bip := airy(x)
+	return bip
+}
+
+// airy returns AiryAi, AiryAiPrime, AiryBi and AiryBiPrime at finite x.
+func airy(x float64) (ai, aip, bi, bip float64) {
+	const (
+		xsmall = 1
+		xlarge = 10
+	)
+	switch xabs := math.Abs(x); {
+	case xabs <= xsmall:
+		return airy_series(x)
+	case xabs >= xlarge:
+		return airy_asymptotic(x)
+	default:
+		return airy_bessel(x)
+	}
+}
+
+// airy_series returns the Airy functions and their derivatives in terms of the
+// 0F1 functions
+//
+//	f(x) = 0F1(; 2/3; x**3/9)           f'(x) = x**2/2 0F1(; 5/3; x**3/9)
+//	g(x) = x 0F1(; 4/3; x**3/9)         g'(x) = 0F1(; 1/3; x**3/9)
+//
+// where AiryAi(x) = c1 f(x) - c2 g(x) and AiryBi(x) = Sqrt(3) [c1 f(x) + c2 g(x)].
+// See 10.4.2-10.4.3, p446, Abramowitz & Stegun.
+func airy_series(x float64) (ai, aip, bi, bip float64) {
+	const (
+		c1    = 0.355028053887817239260063186004 // AiryAi(0)
+		c2    = 0.258819403792806798405183560189 // -AiryAiPrime(0)
+		sqrt3 = 1.732050807568877293527446341506
+	)
+	z := x * x * x / 9
+	f := HypPFQ([]float64{}, []float64{2. / 3}, z)
+	g := x * HypPFQ([]float64{}, []float64{4. / 3}, z)
+	fp := x * x / 2 * HypPFQ([]float64{}, []float64{5. / 3}, z)
+	gp := HypPFQ([]float64{}, []float64{1. / 3}, z)
+	return c1*f - c2*g, c1*fp - c2*gp, sqrt3 * (c1*f + c2*g), sqrt3 * (c1*fp + c2*gp)
+}
+
+// airy_bessel returns the Airy functions and their derivatives in terms of Bessel functions
+// of order ±1/3 and ±2/3 with argument ζ = (2/3) |x|**(3/2). See 10.4.14-10.4.31, p447,
+// Abramowitz & Stegun.
+func airy_bessel(x float64) (ai, aip, bi, bip float64) {
+	const sqrt3 = 1.732050807568877293527446341506
+
+	t := math.Abs(x)
+	zeta := 2. / 3 * t * math.Sqrt(t)
+	rt := math.Sqrt(t / 3)
+
+	if x > 0 {
+		k1, k2 := BesselK(1./3, zeta), BesselK(2./3, zeta)
+		ai = rt * k1 / math.Pi
+		aip = -t / sqrt3 * k2 / math.Pi
+		bi = rt * (BesselI(1./3, zeta) + BesselI(-1./3, zeta))
+		bip = t / sqrt3 * (BesselI(2./3, zeta) + BesselI(-2./3, zeta))
+		return
+	}
+
+	j1, jm1 := BesselJ(1./3, zeta), BesselJ(-1./3, zeta)
+	j2, jm2 := BesselJ(2./3, zeta), BesselJ(-2./3, zeta)
+	ai = rt / sqrt3 * (j1 + jm1)
+	aip = t / 3 * (j2 - jm2)
+	bi = rt * (jm1 - j1)
+	bip = t / sqrt3 * (jm2 + j2)
+	return
+}
+
+// airy_asymptotic returns the Airy functions and their derivatives using the asymptotic
+// expansions for large |x| in powers of 1/ζ, where ζ = (2/3) |x|**(3/2), with coefficients
+//
+//	u[k] = (2k+1)(2k+3)...(6k-1) / (216**k k!),    v[k] = -u[k] (6k+1)/(6k-1)
+//
+// See 9.7.5-9.7.12, Digital Library of Mathematical Functions (https://dlmf.nist.gov/9.7).
+func airy_asymptotic(x float64) (ai, aip, bi, bip float64) {
+	const (
+		maxiter = 100
+		tol     = 1e-17
+	)
+
+	t := math.Abs(x)
+	zeta := 2. / 3 * t * math.Sqrt(t)
+	t4 := math.Sqrt(math.Sqrt(t))
+	zinv := 1 / zeta
+
+	// Partial sums of u[k]/ζ**k and v[k]/ζ**k, grouped by k mod 4.
+	var su, sv [4]float64
+	tu, tv := 1.0, 1.0
+	su[0], sv[0] = 1, 1
+	for k := 1; k < maxiter; k++ {
+		kk := float64(k)
+		tunext := tu * zinv * (6*kk - 5) * (6*kk - 3) * (6*kk - 1) / (216 * kk * (2*kk - 1))
+		// Stop when the series starts to diverge.
+		if math.Abs(tunext) > math.Abs(tu) {
+			break
+		}
+		tu = tunext
+		tv = -tu * (6*kk + 1) / (6*kk - 1)
+		su[k&3] += tu
+		sv[k&3] += tv
+		if math.Abs(tu) < tol {
+			break
+		}
+	}
+
+	if x > 0 {
+		e := math.Exp(-zeta)
+		ai = e / (2 * math.SqrtPi * t4) * (su[0] - su[1] + su[2] - su[3])
+		aip = -t4 * e / (2 * math.SqrtPi) * (sv[0] - sv[1] + sv[2] - sv[3])
+		e = math.Exp(zeta)
+		bi = e / (math.SqrtPi * t4) * (su[0] + su[1] + su[2] + su[3])
+		bip = t4 * e / math.SqrtPi * (sv[0] + sv[1] + sv[2] + sv[3])
+		return
+	}
+
+	// Oscillatory region, with phase ζ - π/4.
+	sin, cos := math.Sincos(zeta)
+	s := (sin - cos) / math.Sqrt2
+	c := (sin + cos) / math.Sqrt2
+
+	pu, qu := su[0]-su[2], su[1]-su[3]
+	pv, qv := sv[0]-sv[2], sv[1]-sv[3]
+	ai = (c*pu + s*qu) / (math.SqrtPi * t4)
+	bi = (-s*pu + c*qu) / (math.SqrtPi * t4)
+	aip = t4 * (s*pv - c*qv) / math.SqrtPi
+	bip = t4 * (c*pv + s*qv) / math.SqrtPi
+	return
+}
@@ -0,0 +1,144 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestAiryAi(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{-inf, 0},
+		{+inf, 0},
+		{-30, -0.087968188456842164},
+		{-12, -0.066555175054373125},
+		{-9.99, 0.050182117162140015},
+		{-7.3, 0.3357703705151473},
+		{-3, -0.37881429367765806},
+		{-1, 0.53556088329235207},
+		{-0.3, 0.43090309528558085},
+		{0, 0.35502805388781722},
+		{0.5, 0.23169360648083348},
+		{1.01, 0.13370770246895858},
+		{2.5, 0.015725923380470491},
+		{6, 9.9476943602528888e-06},
+		{10, 1.1047532552898686e-10},
+		{15, 2.1649625207379925e-18},
+		{40, 6.3657426585529149e-75},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := AiryAi(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestAiryAiPrime(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{-inf, nan},
+		{+inf, 0},
+		{-30, 1.2286206026374851},
+		{-12, 1.0231104533679707},
+		{-9.99, 0.9917458433554861},
+		{-7.3, -0.18009580448329324},
+		{-3, 0.31458376921659881},
+		{-1, -0.01016056711664521},
+		{-0.3, -0.24054512725815461},
+		{0, -0.25881940379280682},
+		{0.5, -0.22491053266468389},
+		{1.01, -0.15779574022638146},
+		{2.5, -0.026250881035903232},
+		{6, -2.4765200397034955e-05},
+		{10, -3.5206336767389237e-10},
+		{15, -8.4205679540177723e-18},
+		{40, -4.0300179776006779e-74},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := AiryAiPrime(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestAiryBi(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{-inf, 0},
+		{+inf, +inf},
+		{-30, -0.22444694220056632},
+		{-12, -0.29571991207807308},
+		{-9.99, -0.31332861305646098},
+		{-7.3, 0.070874113769896316},
+		{-3, -0.19828962637492653},
+		{-1, 0.10399738949694461},
+		{-0.3, 0.47797784010989297},
+		{0, 0.61492662744600068},
+		{0.5, 0.85427704310315544},
+		{1.01, 1.2168086833947425},
+		{2.5, 6.4816607384605787},
+		{6, 6536.4461048098638},
+		{10, 455641153.54822516},
+		{15, 18982099567493588},
+		{40, 3.9531393024385939e+72},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := AiryBi(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestAiryBiPrime(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{-inf, nan},
+		{+inf, +inf},
+		{-30, -0.48369472582768147},
+		{-12, -0.23673219783112331},
+		{-9.99, 0.15080146093189015},
+		{-7.3, 0.90998427043632468},
+		{-3, -0.67561122268525853},
+		{-1, 0.5923756264227924},
+		{-0.3, 0.47188021630064791},
+		{0, 0.44828835735382638},
+		{0.5, 0.5445725641405923},
+		{1.01, 0.94461767677857544},
+		{2.5, 9.4214233173343018},
+		{6, 15725.602621930477},
+		{10, 1429236134.4828658},
+		{15, 73197492034070112},
+		{40, 2.497707968170697e+73},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := AiryBiPrime(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -0,0 +1,389 @@
+package special
+
+import "math"
+
+// The implementation of besselik_steed follows the method of Temme and Steed, as described in:
+// W. H. Press et al. Numerical Recipes, 3rd ed., §6.6, Cambridge University Press (2007).
+
+// BesselI returns the modified Bessel function of the first kind of real order nu, defined by
+//
+//	                 ∞
+//	BesselI(nu, x) = ∑ (x/2)**(2k+nu) / [k! Gamma(k+nu+1)]
+//	                k=0
+//
+// For x < 0 the result is real only for integer nu, in which case
+// BesselI(nu, -x) = (-1)**nu BesselI(nu, x), otherwise it is NaN.
+//
+// See http://mathworld.wolfram.com/ModifiedBesselFunctionoftheFirstKind.html for more information.
+func BesselI(nu, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(nu) || math.IsNaN(x) || math.IsInf(nu, -1):
+		return math.NaN()
+	case x < 0:
+		if nu != math.Trunc(nu) {
+			return math.NaN()
+		}
+		return cosPi(nu) * BesselI(nu, -x)
+	case math.IsInf(nu, 1):
+		return 0
+	case math.IsInf(x, 1):
+		return x
+	case x == 0:
+		return besselik_zero(nu)
+	}
+
+	ie, ke := besselik(math.Abs(nu), x)
+	if nu < 0 {
+		ie = besselik_reflect(nu, x, ie, ke)
+	}
+	return besselik_unscale(ie, x)
+}
+
+// BesselIe returns the exponentially scaled modified Bessel function of the first kind,
+// defined by
+//
+//	BesselIe(nu, x) = Exp(-|x|) BesselI(nu, x)
+//
+// which remains finite for large |x|, where BesselI overflows.
+//
+// See http://mathworld.wolfram.com/ModifiedBesselFunctionoftheFirstKind.html for more information.
+func BesselIe(nu, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(nu) || math.IsNaN(x) || math.IsInf(nu, -1):
+		return math.NaN()
+	case x < 0:
+		if nu != math.Trunc(nu) {
+			return math.NaN()
+		}
+		return cosPi(nu) * BesselIe(nu, -x)
+	case math.IsInf(nu, 1) || math.IsInf(x, 1):
+		return 0
+	case x == 0:
+		return besselik_zero(nu)
+	}
+
+	ie, ke := besselik(math.Abs(nu), x)
+	if nu < 0 {
+		ie = besselik_reflect(nu, x, ie, ke)
+	}
+	return ie
+}
+
+// BesselK returns the modified Bessel function of the second kind of real order nu, defined by
+//
+//	BesselK(nu, x) = (π/2) [BesselI(-nu, x) - BesselI(nu, x)] / Sin(nu π)
+//
+// for non-integer nu, and by the limiting value of the right-hand side for integer nu.
+// BesselK is an even function of nu and is complex, and hence NaN, for x < 0.
+//
+// See http://mathworld.wolfram.com/ModifiedBesselFunctionoftheSecondKind.html for more information.
+func BesselK(nu, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(nu) || math.IsNaN(x) || x < 0:
+		return math.NaN()
+	case math.IsInf(x, 1):
+		return 0
+	case math.IsInf(nu, 0) || x == 0:
+		return math.Inf(1)
+	}
+
+	_, ke := besselik(math.Abs(nu), x)
+	return ke * math.Exp(-x)
+}
+
+// BesselKe returns the exponentially scaled modified Bessel function of the second kind,
+// defined by
+//
+//	BesselKe(nu, x) = Exp(x) BesselK(nu, x)
+//
+// which remains representable for large x, where BesselK underflows.
+//
+// See http://mathworld.wolfram.com/ModifiedBesselFunctionoftheSecondKind.html for more information.
+func BesselKe(nu, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(nu) || math.IsNaN(x) || x < 0:
+		return math.NaN()
+	case math.IsInf(x, 1):
+		return 0
+	case math.IsInf(nu, 0) || x == 0:
+		return math.Inf(1)
+	}
+
+	_, ke := besselik(math.Abs(nu), x)
+	return ke
+}
+
+// besselik_zero returns BesselI(nu, 0).
+func besselik_zero(nu float64) float64 {
+	switch {
+	case nu == 0:
+		return 1
+	case nu > 0 || nu == math.Trunc(nu):
+		return 0
+	}
+	return float64(GammaSign(nu+1)) * math.Inf(1)
+}
+
+// besselik_reflect returns the scaled BesselIe(nu, x) for nu < 0 using the reflection formula
+//
+//	BesselI(nu, x) = BesselI(-nu, x) + (2/π) Sin(-nu π) BesselK(-nu, x)
+//
+// given the scaled values ie and ke of order -nu.
+func besselik_reflect(nu, x, ie, ke float64) float64 {
+	s := sinPi(-nu)
+	if s == 0 {
+		return ie
+	}
+	return ie + 2/math.Pi*s*ke*math.Exp(-2*x)
+}
+
+// besselik_unscale returns Exp(x) ie, avoiding spurious overflow of Exp(x).
+func besselik_unscale(ie, x float64) float64 {
+	const xover = 709
+	if x < xover || ie == 0 {
+		return ie * math.Exp(x)
+	}
+	return math.Copysign(math.Exp(x+math.Log(math.Abs(ie))), ie)
+}
+
+// besselik returns the scaled functions Exp(-x) BesselI(nu, x) and Exp(x) BesselK(nu, x)
+// for nu ≥ 0 and x > 0.
+func besselik(nu, x float64) (float64, float64) {
+	const (
+		xsmall = 1e-3
+		xlarge = 20
+	)
+	switch {
+	case x >= math.Max(xlarge, nu*nu):
+		return besselik_asymptotic(nu, x)
+	case x < xsmall:
+		// The Wronskian normalisation of BesselI in besselik_steed loses accuracy for small x.
+		_, ke := besselik_steed(nu, x)
+		return besselik_series(nu, x), ke
+	}
+	return besselik_steed(nu, x)
+}
+
+// besselik_series returns the scaled function Exp(-x) BesselI(nu, x) for nu ≥ 0 and small x > 0
+// using the series
+//
+//	                                             ∞
+//	BesselI(nu, x) = (x/2)**nu / Gamma(nu+1)  ∑ (x²/4)**k / [k! (nu+1)[k]]
+//	                                            k=0
+//
+// where (nu+1)[k] is the Pochhammer symbol.
+func besselik_series(nu, x float64) float64 {
+	const (
+		maxiter = 100
+		tol     = 1e-17
+	)
+
+	y := x * x / 4
+	sum := 1.0
+	t := 1.0
+	for k := 1.0; k < maxiter; k++ {
+		t *= y / (k * (k + nu))
+		sum += t
+		if t < tol*sum {
+			break
+		}
+	}
+	lg, _ := math.Lgamma(nu + 1)
+	return sum * math.Exp(nu*math.Log(x/2)-lg-x)
+}
+
+// besselik_asymptotic returns the scaled functions Exp(-x) BesselI(nu, x) and
+// Exp(x) BesselK(nu, x) using the asymptotic expansions for large x, i.e.
+//
+//	                                    ∞
+//	BesselI(nu, x) ~ Exp(x) / Sqrt(2πx) ∑ (-1)**k a[k](nu) / x**k
+//	                                   k=0
+//
+//	                                   ∞
+//	BesselK(nu, x) ~ Sqrt(π/(2x)) Exp(-x) ∑ a[k](nu) / x**k
+//	                                  k=0
+//
+// where a[k](nu) = (4nu²-1)(4nu²-9)...(4nu²-(2k-1)²) / (k! 8**k). See 9.7.1-9.7.2, p377,
+// Abramowitz & Stegun.
+func besselik_asymptotic(nu, x float64) (float64, float64) {
+	const (
+		maxiter = 100
+		tol     = 1e-17
+	)
+
+	mu := 4 * nu * nu
+	si, sk := 1.0, 1.0
+	t := 1.0
+	for k := 1; k < maxiter; k++ {
+		j := float64(2*k - 1)
+		tnext := t * (mu - j*j) / (8 * float64(k) * x)
+		// Stop when the series terminates or starts to diverge.
+		if tnext == 0 || math.Abs(tnext) > math.Abs(t) {
+			break
+		}
+		t = tnext
+		sk += t
+		if k&1 == 0 {
+			si += t
+		} else {
+			si -= t
+		}
+		if math.Abs(t) < tol {
+			break
+		}
+	}
+	return si / math.Sqrt(2*math.Pi*x), sk * math.Sqrt(math.Pi/(2*x))
+}
+
+// besselik_steed returns the scaled functions Exp(-x) BesselI(nu, x) and Exp(x) BesselK(nu, x)
+// for nu ≥ 0 and x > 0.
+//
+// The ratio BesselI'(nu, x) / BesselI(nu, x) is obtained from a continued fraction and downward
+// recurrence to the order mu = nu - n, where |mu| ≤ 1/2. BesselK(mu, x) and BesselK(mu+1, x) are
+// then calculated with Temme's series for x < 2 or Steed's continued fraction for x ≥ 2, and the
+// Wronskian fixes the normalisation of BesselI. Finally, BesselK(nu, x) is obtained by forward
+// recurrence from BesselK(mu, x).
+func besselik_steed(nu, x float64) (float64, float64) {
+	const (
+		maxiter = 1000000
+		tol     = 1e-16
+		tiny    = 1e-300
+		big     = 1e250
+		xmin    = 2
+	)
+
+	n := int(nu + 0.5)
+	mu := nu - float64(n)
+	mu2 := mu * mu
+	xi := 1 / x
+	xi2 := 2 * xi
+
+	// Evaluate the continued fraction for BesselI'(nu, x) / BesselI(nu, x) using
+	// the modified Lentz method.
+	h := math.Max(nu*xi, tiny)
+	b := xi2 * nu
+	c := h
+	d := 0.0
+	for i := 0; i < maxiter; i++ {
+		b += xi2
+		d = 1 / (b + d)
+		c = b + 1/c
+		del := c * d
+		h *= del
+		if math.Abs(del-1) < tol {
+			break
+		}
+	}
+
+	// Downward recurrence from nu to mu, rescaling to avoid overflow.
+	il := 1.0
+	ipl := h
+	inu := il
+	fact := nu * xi
+	for l := n - 1; l >= 0; l-- {
+		itmp := fact*il + ipl
+		fact -= xi
+		ipl = fact*itmp + il
+		il = itmp
+		if il > big {
+			il /= big
+			ipl /= big
+			inu /= big
+		}
+	}
+	f := ipl / il
+
+	var kmu, k1 float64
+	if x < xmin {
+		// Temme's series for BesselK(mu, x) and BesselK(mu+1, x).
+		x2 := x / 2
+		pimu := math.Pi * mu
+		fact := 1.0
+		if math.Abs(pimu) >= tol {
+			fact = pimu / math.Sin(pimu)
+		}
+		d := -math.Log(x2)
+		e := mu * d
+		fact2 := 1.0
+		if math.Abs(e) >= tol {
+			fact2 = math.Sinh(e) / e
+		}
+		gam1, gam2, gampl, gammi := temme_gamma(mu)
+		ff := fact * (gam1*math.Cosh(e) + gam2*fact2*d)
+		sum := ff
+		e = math.Exp(e)
+		p := e / (2 * gampl)
+		q := 1 / (2 * e * gammi)
+		c := 1.0
+		d = x2 * x2
+		sum1 := p
+		for i := 1; i < maxiter; i++ {
+			fi := float64(i)
+			ff = (fi*ff + p + q) / (fi*fi - mu2)
+			c *= d / fi
+			p /= fi - mu
+			q /= fi + mu
+			del := c * ff
+			sum += del
+			sum1 += c * (p - fi*ff)
+			if math.Abs(del) < math.Abs(sum)*tol {
+				break
+			}
+		}
+		ex := math.Exp(x)
+		kmu = sum * ex
+		k1 = sum1 * xi2 * ex
+	} else {
+		// Steed's continued fraction for BesselK(mu+1, x) / BesselK(mu, x), combined with
+		// Temme's normalisation of BesselK(mu, x).
+		b := 2 * (1 + x)
+		d := 1 / b
+		h := d
+		delh := d
+		q1 := 0.0
+		q2 := 1.0
+		a1 := 0.25 - mu2
+		q := a1
+		c := a1
+		a := -a1
+		s := 1 + q*delh
+		for i := 1; i < maxiter; i++ {
+			a -= float64(2 * i)
+			c = -a * c / float64(i+1)
+			qnew := (q1 - b*q2) / a
+			q1 = q2
+			q2 = qnew
+			q += c * qnew
+			b += 2
+			d = 1 / (b + a*d)
+			delh = (b*d - 1) * delh
+			h += delh
+			dels := q * delh
+			s += dels
+			if math.Abs(dels/s) < tol {
+				break
+			}
+		}
+		h *= a1
+		kmu = math.Sqrt(math.Pi/(2*x)) / s
+		k1 = kmu * (mu + x + 0.5 - h) * xi
+	}
+
+	kpmu := mu*xi*kmu - k1
+	imu := xi / (f*kmu - kpmu)
+
+	// Forward recurrence for BesselK, which is stable.
+	for i := 1; i <= n; i++ {
+		if math.IsInf(k1, 0) {
+			kmu = math.Inf(1)
+			break
+		}
+		kmu, k1 = k1, (mu+float64(i))*xi2*k1+kmu
+	}
+
+	return imu * inu / il, kmu
+}
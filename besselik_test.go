@@ -0,0 +1,140 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestBesselI(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 1, nan},
+		{1, nan, nan},
+		{-inf, 1, nan},
+		{+inf, 1, 0},
+		{1, +inf, +inf},
+		{0, 0, 1},
+		{2.5, 0, 0},
+		{-3, 0, 0},
+		{-0.5, 0, +inf},
+		{0.5, -1, nan},
+		{3, -2, -0.21273995923985264},
+		{0, 0.01, 1.0000250001562505},
+		{0, 1, 1.2660658777520084},
+		{1, 2, 1.5906368546373291},
+		{2.5, 5, 13.766882138682583},
+		{4.4, 19.9, 24035936.676980406},
+		{7, 35, 52888369066045.859},
+		{15, 5, 1.0479776754179188e-06},
+		{-0.3, 1.99, 2.2220408483835317},
+		{-2.5, 12, 14448.198922056561},
+		{-3, 2, 0.21273995923985264},
+		{0.25, 100, 1.0734145166453238e+42},
+		{50, 10, 4.75689456072684e-30},
+		{0, 1000, +inf},
+		{0.5, 1e-14, 7.9788456080286533e-08},
+		{0.5, 1e-10, 7.9788456080286543e-06},
+		{0.5, 1e-8, 7.9788456080286536e-05},
+		{1.5, 1e-14, 2.6596152026762178e-22},
+		{1.5, 1e-8, 2.659615202676218e-13},
+		{-0.5, 1e-14, 7978845.608028654},
+		{-0.5, 1e-8, 7978.8456080286542},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := BesselI(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBesselIe(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 1, nan},
+		{1, +inf, 0},
+		{0, 0, 1},
+		{0.5, -1, nan},
+		{0, 500, 0.017845706500153168},
+		{1, 1000, 0.012610930256928629},
+		{-1, -1000, -0.012610930256928629},
+		{2.5, 50, 0.053101523603514819},
+		{-0.5, 3, 0.23090036256424204},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := BesselIe(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBesselK(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 1, nan},
+		{1, nan, nan},
+		{1, -1, nan},
+		{+inf, 1, +inf},
+		{-inf, 1, +inf},
+		{1, +inf, 0},
+		{0, 0, +inf},
+		{0, 0.01, 4.7212447301610947},
+		{0, 1, 0.42102443824070834},
+		{1, 2, 0.13986588181652243},
+		{2.5, 5, 0.0064957750043857579},
+		{4.4, 19.9, 1.0209089897231269e-09},
+		{7, 35, 2.648855232689269e-16},
+		{15, 5, 30169.766300673204},
+		{-0.3, 1.99, 0.11748072729765913},
+		{-2.5, 12, 2.8250369353706522e-06},
+		{-3, 2, 0.64738539094863412},
+		{0.25, 100, 4.6580764515098396e-45},
+		{50, 10, 2.0613737753892576e+27},
+		{0, 1000, 0},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := BesselK(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBesselKe(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 1, nan},
+		{1, -1, nan},
+		{1, +inf, 0},
+		{0, 0, +inf},
+		{0, 500, 0.056035915417234516},
+		{1, 1000, 0.03964813081296021},
+		{2.5, 50, 0.18809280265809336},
+		{-0.5, 3, 0.72360125455826763},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := BesselKe(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
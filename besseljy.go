@@ -0,0 +1,379 @@
+package special
+
+import "math"
+
+// The implementation of besseljy_steed follows the method of Temme and Steed, as described in:
+// W. H. Press et al. Numerical Recipes, 3rd ed., §6.6, Cambridge University Press (2007).
+
+// BesselJ returns the Bessel function of the first kind of real order nu, defined by
+//
+//	                 ∞
+//	BesselJ(nu, x) = ∑ (-1)**k (x/2)**(2k+nu) / [k! Gamma(k+nu+1)]
+//	                k=0
+//
+// For x < 0 the result is real only for integer nu, in which case
+// BesselJ(nu, -x) = (-1)**nu BesselJ(nu, x), otherwise it is NaN.
+//
+// See http://mathworld.wolfram.com/BesselFunctionoftheFirstKind.html for more information.
+func BesselJ(nu, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(nu) || math.IsNaN(x) || math.IsInf(nu, -1):
+		return math.NaN()
+	case x < 0:
+		if nu != math.Trunc(nu) {
+			return math.NaN()
+		}
+		return cosPi(nu) * BesselJ(nu, -x)
+	case math.IsInf(x, 1) || math.IsInf(nu, 1):
+		return 0
+	case x == 0:
+		switch {
+		case nu == 0:
+			return 1
+		case nu > 0 || nu == math.Trunc(nu):
+			return 0
+		}
+		return float64(GammaSign(nu+1)) * math.Inf(1)
+	}
+
+	if nu < 0 {
+		j, y := besseljy(-nu, x)
+		return besseljy_reflect(cosPi(nu), sinPi(nu), j, y)
+	}
+
+	j, _ := besseljy(nu, x)
+	return j
+}
+
+// BesselY returns the Bessel function of the second kind of real order nu, defined by
+//
+//	BesselY(nu, x) = [BesselJ(nu, x) Cos(nu π) - BesselJ(-nu, x)] / Sin(nu π)
+//
+// for non-integer nu, and by the limiting value of the right-hand side for integer nu.
+// BesselY is complex, and hence NaN, for x < 0.
+//
+// See http://mathworld.wolfram.com/BesselFunctionoftheSecondKind.html for more information.
+func BesselY(nu, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(nu) || math.IsNaN(x) || math.IsInf(nu, -1) || x < 0:
+		return math.NaN()
+	case math.IsInf(x, 1):
+		return 0
+	case math.IsInf(nu, 1):
+		return math.Inf(-1)
+	}
+
+	if nu < 0 {
+		if x == 0 {
+			return besseljy_reflect(sinPi(-nu), cosPi(-nu), BesselJ(-nu, 0), math.Inf(-1))
+		}
+		j, y := besseljy(-nu, x)
+		return besseljy_reflect(sinPi(-nu), cosPi(-nu), j, y)
+	}
+
+	if x == 0 {
+		return math.Inf(-1)
+	}
+
+	_, y := besseljy(nu, x)
+	return y
+}
+
+// besseljy_reflect returns c*j + s*y, which gives the Bessel functions of negative
+// order in terms of those of positive order. A zero coefficient suppresses its term
+// so that infinite values of j or y do not produce NaN.
+func besseljy_reflect(c, s, j, y float64) float64 {
+	res := 0.0
+	if c != 0 {
+		res += c * j
+	}
+	if s != 0 {
+		res += s * y
+	}
+	return res
+}
+
+// besseljy returns BesselJ(nu, x) and BesselY(nu, x) for nu ≥ 0 and x > 0.
+func besseljy(nu, x float64) (float64, float64) {
+	const xlarge = 20
+	if x >= math.Max(xlarge, nu*nu) {
+		return besseljy_hankel(nu, x)
+	}
+	return besseljy_steed(nu, x)
+}
+
+// besseljy_hankel returns BesselJ(nu, x) and BesselY(nu, x) using Hankel's asymptotic
+// expansion for large x, i.e.
+//
+//	BesselJ(nu, x) ~ Sqrt(2/(πx)) [P(nu, x) Cos(χ) - Q(nu, x) Sin(χ)]
+//	BesselY(nu, x) ~ Sqrt(2/(πx)) [P(nu, x) Sin(χ) + Q(nu, x) Cos(χ)]
+//
+// where χ = x - (nu/2 + 1/4)π. See 9.2.5-9.2.10, p364, Abramowitz & Stegun.
+func besseljy_hankel(nu, x float64) (float64, float64) {
+	const (
+		maxiter = 100
+		tol     = 1e-17
+	)
+
+	mu := 4 * nu * nu
+	p, q := 1.0, 0.0
+	t := 1.0
+	for k := 1; k < maxiter; k++ {
+		j := float64(2*k - 1)
+		tnext := t * (mu - j*j) / (8 * float64(k) * x)
+		// Stop when the series terminates or starts to diverge.
+		if tnext == 0 || math.Abs(tnext) > math.Abs(t) {
+			break
+		}
+		t = tnext
+		switch k & 3 {
+		case 0:
+			p += t
+		case 1:
+			q += t
+		case 2:
+			p -= t
+		case 3:
+			q -= t
+		}
+		if math.Abs(t) < tol {
+			break
+		}
+	}
+
+	// Cos(χ) and Sin(χ) are computed from the addition formulae to preserve
+	// the accuracy of the argument reduction of x.
+	sx, cx := math.Sincos(x)
+	sphi, cphi := sinPi(nu/2+1./4), cosPi(nu/2+1./4)
+	cchi := cx*cphi + sx*sphi
+	schi := sx*cphi - cx*sphi
+
+	s := math.Sqrt(2 / (math.Pi * x))
+	return s * (p*cchi - q*schi), s * (p*schi + q*cchi)
+}
+
+// besseljy_steed returns BesselJ(nu, x) and BesselY(nu, x) for nu ≥ 0 and x > 0.
+//
+// The ratio BesselJ'(nu, x) / BesselJ(nu, x) is obtained from a continued fraction and
+// downward recurrence to the order mu = nu - n, where |mu| ≤ 1/2 for x < 2. BesselY(mu, x)
+// is then calculated with Temme's series for x < 2 or Steed's complex continued fraction
+// for x ≥ 2, and the Wronskian fixes the normalisation of BesselJ. Finally, BesselY(nu, x)
+// is obtained by forward recurrence from BesselY(mu, x).
+func besseljy_steed(nu, x float64) (float64, float64) {
+	const (
+		maxiter = 1000000
+		tol     = 1e-16
+		tiny    = 1e-300
+		big     = 1e250
+		xmin    = 2
+	)
+
+	var n int
+	if x < xmin {
+		n = int(nu + 0.5)
+	} else {
+		n = int(math.Max(0, nu-x+1.5))
+	}
+	mu := nu - float64(n)
+	mu2 := mu * mu
+	xi := 1 / x
+	xi2 := 2 * xi
+	w := xi2 / math.Pi
+
+	// Evaluate the continued fraction for BesselJ'(nu, x) / BesselJ(nu, x) using
+	// the modified Lentz method, keeping track of the sign of BesselJ(nu, x).
+	sign := 1.0
+	h := math.Max(nu*xi, tiny)
+	b := xi2 * nu
+	c := h
+	d := 0.0
+	for i := 0; i < maxiter; i++ {
+		b += xi2
+		d = b - d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b - 1/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := c * d
+		h *= del
+		if d < 0 {
+			sign = -sign
+		}
+		if math.Abs(del-1) <= tol {
+			break
+		}
+	}
+
+	// Downward recurrence from nu to mu, rescaling to avoid overflow.
+	jl := sign
+	jpl := h * jl
+	jnu := jl
+	fact := nu * xi
+	for l := n - 1; l >= 0; l-- {
+		jtmp := fact*jl + jpl
+		fact -= xi
+		jpl = fact*jtmp - jl
+		jl = jtmp
+		if math.Abs(jl) > big {
+			jl /= big
+			jpl /= big
+			jnu /= big
+		}
+	}
+	if jl == 0 {
+		jl = tol
+	}
+	f := jpl / jl
+
+	var jmu, ymu, y1 float64
+	if x < xmin {
+		// Temme's series for BesselY(mu, x) and BesselY(mu+1, x).
+		x2 := x / 2
+		pimu := math.Pi * mu
+		fact := 1.0
+		if math.Abs(pimu) >= tol {
+			fact = pimu / math.Sin(pimu)
+		}
+		d := -math.Log(x2)
+		e := mu * d
+		fact2 := 1.0
+		if math.Abs(e) >= tol {
+			fact2 = math.Sinh(e) / e
+		}
+		gam1, gam2, gampl, gammi := temme_gamma(mu)
+		ff := 2 / math.Pi * fact * (gam1*math.Cosh(e) + gam2*fact2*d)
+		e = math.Exp(e)
+		p := e / (gampl * math.Pi)
+		q := 1 / (e * math.Pi * gammi)
+		pimu2 := pimu / 2
+		fact3 := 1.0
+		if math.Abs(pimu2) >= tol {
+			fact3 = math.Sin(pimu2) / pimu2
+		}
+		r := math.Pi * pimu2 * fact3 * fact3
+		c := 1.0
+		d = -x2 * x2
+		sum := ff + r*q
+		sum1 := p
+		for i := 1; i < maxiter; i++ {
+			fi := float64(i)
+			ff = (fi*ff + p + q) / (fi*fi - mu2)
+			c *= d / fi
+			p /= fi - mu
+			q /= fi + mu
+			del := c * (ff + r*q)
+			sum += del
+			sum1 += c*p - fi*del
+			if math.Abs(del) < (1+math.Abs(sum))*tol {
+				break
+			}
+		}
+		ymu = -sum
+		y1 = -sum1 * xi2
+		ypmu := mu*xi*ymu - y1
+		jmu = w / (ypmu - f*ymu)
+	} else {
+		// Steed's continued fraction for p + iq = [BesselH1'(mu, x)/BesselH1(mu, x)],
+		// where BesselH1 = BesselJ + i BesselY is the Hankel function.
+		a := 0.25 - mu2
+		p := -xi / 2
+		q := 1.0
+		br := 2 * x
+		bi := 2.0
+		fact := a * xi / (p*p + q*q)
+		cr := br + q*fact
+		ci := bi + p*fact
+		den := br*br + bi*bi
+		dr := br / den
+		di := -bi / den
+		dlr := cr*dr - ci*di
+		dli := cr*di + ci*dr
+		p, q = p*dlr-q*dli, p*dli+q*dlr
+		for i := 1; i < maxiter; i++ {
+			a += float64(2 * i)
+			bi += 2
+			dr = a*dr + br
+			di = a*di + bi
+			if math.Abs(dr)+math.Abs(di) < tiny {
+				dr = tiny
+			}
+			fact = a / (cr*cr + ci*ci)
+			cr = br + cr*fact
+			ci = bi - ci*fact
+			if math.Abs(cr)+math.Abs(ci) < tiny {
+				cr = tiny
+			}
+			den = dr*dr + di*di
+			dr /= den
+			di /= -den
+			dlr = cr*dr - ci*di
+			dli = cr*di + ci*dr
+			p, q = p*dlr-q*dli, p*dli+q*dlr
+			if math.Abs(dlr-1)+math.Abs(dli) <= tol {
+				break
+			}
+		}
+		gam := (p - f) / q
+		jmu = math.Copysign(math.Sqrt(w/((p-f)*gam+q)), jl)
+		ymu = jmu * gam
+		ypmu := ymu * (p + q/gam)
+		y1 = mu*xi*ymu - ypmu
+	}
+
+	// Forward recurrence for BesselY, which is stable.
+	for i := 1; i <= n; i++ {
+		if math.IsInf(y1, 0) {
+			ymu = math.Inf(-1)
+			break
+		}
+		ymu, y1 = y1, (mu+float64(i))*xi2*y1-ymu
+	}
+
+	return jnu * jmu / jl, ymu
+}
+
+// temme_gamma returns the auxiliary functions
+//
+//	gam1 = [1/Gamma(1-mu) - 1/Gamma(1+mu)] / (2mu)
+//	gam2 = [1/Gamma(1-mu) + 1/Gamma(1+mu)] / 2
+//
+// along with 1/Gamma(1+mu) and 1/Gamma(1-mu), for |mu| ≤ 1/2. These are evaluated from
+// the Taylor series of 1/Gamma(1+mu) to avoid cancellation for small mu.
+func temme_gamma(mu float64) (gam1, gam2, gampl, gammi float64) {
+	// Coefficients of the Taylor series of 1/Gamma(1+x) about x=0.
+	const (
+		c1  = 5.77215664901532865549e-01
+		c2  = -6.55878071520253902449e-01
+		c3  = -4.20026350340952370210e-02
+		c4  = 1.66538611382291479313e-01
+		c5  = -4.21977345555443333902e-02
+		c6  = -9.62197152787697303211e-03
+		c7  = 7.21894324666309990246e-03
+		c8  = -1.16516759185906516871e-03
+		c9  = -2.15241674114950975192e-04
+		c10 = 1.28050282388116195512e-04
+		c11 = -2.01348547807882386862e-05
+		c12 = -1.25049348214267063072e-06
+		c13 = 1.13302723198169592860e-06
+		c14 = -2.05633841697760707339e-07
+		c15 = 6.11609510448141608721e-09
+		c16 = 5.00200764446922294544e-09
+		c17 = -1.18127457048702004406e-09
+		c18 = 1.04342671169110053979e-10
+		c19 = 7.78226343990507081432e-12
+		c20 = -3.69680561864220597869e-12
+		c21 = 5.10037028745447575372e-13
+		c22 = -2.05832605356650663575e-14
+		c23 = -5.34812253942301782029e-15
+	)
+	y := mu * mu
+	gam1 = -poly(y, c1, c3, c5, c7, c9, c11, c13, c15, c17, c19, c21, c23)
+	gam2 = poly(y, 1, c2, c4, c6, c8, c10, c12, c14, c16, c18, c20, c22)
+	return gam1, gam2, gam2 - mu*gam1, gam2 + mu*gam1
+}
@@ -0,0 +1,104 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestBesselJ(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 1, nan},
+		{1, nan, nan},
+		{-inf, 1, nan},
+		{+inf, 1, 0},
+		{1, +inf, 0},
+		{0, 0, 1},
+		{2.5, 0, 0},
+		{-3, 0, 0},
+		{-0.5, 0, +inf},
+		{-1.5, 0, -inf},
+		{0.5, -1, nan},
+		{3, -3, -0.30906272225525167},
+		{0, 0.01, 0.99997500015624952},
+		{0, 1, 0.76519768655796661},
+		{0, 20, 0.16702466434058316},
+		{0, 200, -0.015437439930565091},
+		{0, 1e+06, 0.00033104301373987381},
+		{0.25, 12, -0.041552439750366529},
+		{0.5, 2, 0.51301613656182776},
+		{-0.5, 2, -0.23478571040624846},
+		{1, 2.5, 0.49709410246427405},
+		{1, 30, -0.11875106261662294},
+		{2.3, 1.5, 0.16158024406923358},
+		{2.3, 7, -0.30454084161562317},
+		{-2.5, 3, 0.3690407300737979},
+		{-3, 3, -0.30906272225525167},
+		{4.4, 19.9, 0.17981274267561806},
+		{7, 0.01, 1.5500943622959144e-20},
+		{7, 35, 0.047426316968790294},
+		{10, 5, 0.0014678026473104741},
+		{50, 0.5, 2.5905580660785432e-95},
+		{100, 50, 1.1159273690838094e-21},
+		{100, 150, -0.015359526118405391},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := BesselJ(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBesselY(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 1, nan},
+		{1, nan, nan},
+		{-inf, 1, nan},
+		{1, -1, nan},
+		{+inf, 1, -inf},
+		{1, +inf, 0},
+		{0, 0, -inf},
+		{2.5, 0, -inf},
+		{-0.5, 0, 0},
+		{-3, 0, inf},
+		{0, 0.01, -3.0054556370836458},
+		{0, 1, 0.088256964215676956},
+		{0, 20, 0.062640596809383831},
+		{0, 200, -0.054265775249817912},
+		{0, 1e+06, -0.00072596852233517926},
+		{0.25, 2, 0.39273839961538504},
+		{0.5, 2, 0.23478571040624846},
+		{-0.5, 2, 0.51301613656182776},
+		{1, 2.5, 0.14591813796678579},
+		{1, 30, 0.084425570661747232},
+		{2.3, 1.5, -1.1337270393290535},
+		{2.3, 7, 0.055607549742685967},
+		{-2.5, 3, 0.41271003220971597},
+		{-3, 3, 0.5385416161050316},
+		{7, 0.01, -2.933556134200002e+18},
+		{7, 1.99, -280.76101008419943},
+		{7, 60, -0.10311201709526646},
+		{10, 5, -25.129110095610098},
+		{50, 0.5, -2.4575848224461087e+92},
+		{100, 50, -3.2938001882026665e+18},
+		{100, 150, 0.073876071245019867},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := BesselY(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package special
+
+import "math"
+
+// BetaInc returns the incomplete beta function, defined by
+//
+//	                   x
+//	BetaInc(a, b, x) = ∫ dt t**(a-1) * (1-t)**(b-1)
+//	                  t=0
+//
+// for a, b > 0 and 0 ≤ x ≤ 1. BetaInc also satisfies the identity
+//
+//	BetaInc(a, b, x) = Beta(a, b) * BetaRegI(a, b, x)
+//
+// where Beta is the complete beta function and BetaRegI is the regularised
+// incomplete beta function.
+//
+// See http://mathworld.wolfram.com/IncompleteBetaFunction.html
+// for more information.
+func BetaInc(a, b, x float64) float64 {
+	lb, _ := LgammaRatio([]float64{a, b}, []float64{a + b})
+	return math.Exp(lb + math.Log(BetaRegI(a, b, x)))
+}
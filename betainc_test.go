@@ -0,0 +1,32 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestBetaInc(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, Out float64
+	}{
+		{nan, 1, 0.5, nan},
+		{2, 3, 0, 0},
+		{2, 3, 1, 0.083333333333333333},
+		{2, 3, 0.4, 0.043733333333333339},
+		{0.5, 0.5, 0.1, 0.64350110879328437},
+		{10, 20, 0.5, 4.8391679641202337e-09},
+		{0.1, 5, 0.01, 6.286809002293694},
+		{300, 200, 0.6, 8.2027088703694676e-148},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := BetaInc(c.In1, c.In2, c.In3)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package special
+
+import "math"
+
+// BetaRegI returns the regularised incomplete beta function, defined by
+//
+//	                                     x
+//	BetaRegI(a, b, x) = [1 / Beta(a, b)] ∫ dt t**(a-1) * (1-t)**(b-1)
+//	                                    t=0
+//
+// for a, b > 0 and 0 ≤ x ≤ 1. BetaRegI also satisfies the identity
+//
+//	BetaRegI(a, b, x) + BetaRegI(b, a, 1-x) = 1
+//
+// where Beta is the complete beta function.
+//
+// See http://mathworld.wolfram.com/RegularizedBetaFunction.html
+// for more information.
+func BetaRegI(a, b, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(a) || math.IsNaN(b) || math.IsNaN(x) || a <= 0 || b <= 0 || x < 0 || x > 1:
+		return math.NaN()
+	case math.IsInf(a, 1) && math.IsInf(b, 1):
+		return math.NaN()
+	case x == 0:
+		return 0
+	case x == 1:
+		return 1
+	case math.IsInf(a, 1):
+		return 0
+	case math.IsInf(b, 1):
+		return 1
+	case a == 1 && b == 1:
+		return x
+	}
+
+	// Use the continued fraction directly when it converges rapidly, i.e. for
+	// x < (a+1)/(a+b+2), and otherwise use the symmetry relation.
+	if x*(a+b+2) < a+1 {
+		return betaI_cf(a, b, x, 1-x)
+	}
+	return 1 - betaI_cf(b, a, 1-x, x)
+}
+
+// betaI_cf returns BetaRegI(a, b, x) using a continued fraction, where y = 1-x.
+func betaI_cf(a, b, x, y float64) float64 {
+	const (
+		tiny = 1e-300
+		rtol = 1e-16
+	)
+
+	// The number of iterations required grows like Sqrt(Max(a, b)).
+	maxiter := 200 + 10*int(math.Sqrt(math.Max(a, b)))
+
+	// Evaluate the continued fraction
+	//
+	//	cf = 1 / (1 + d[1] / (1 + d[2] / (1 + ...)))
+	//
+	// with d[2m+1] = -(a+m)(a+b+m)x / ((a+2m)(a+2m+1)) and d[2m] = m(b-m)x / ((a+2m-1)(a+2m))
+	// using the modified Lentz algorithm. See 26.5.8, p944, Abramowitz & Stegun.
+	c := 1.0
+	d := 1 - (a+b)*x/(a+1)
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	cf := d
+	for m := 1; m < maxiter; m++ {
+		fm := float64(m)
+		m2 := 2 * fm
+
+		// Even step.
+		dm := fm * (b - fm) * x / ((a + m2 - 1) * (a + m2))
+		d = 1 + dm*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + dm/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		cf *= d * c
+
+		// Odd step.
+		dm = -(a + fm) * (a + b + fm) * x / ((a + m2) * (a + m2 + 1))
+		d = 1 + dm*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + dm/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		cf *= delta
+		if math.Abs(delta-1) < rtol {
+			break
+		}
+	}
+	return betaI_power(a, b, x, y) * cf / a
+}
+
+// betaI_power returns x**a * y**b / Beta(a, b), where y = 1-x, avoiding the cancellation
+// between the logarithms of the gamma functions for large a and b.
+func betaI_power(a, b, x, y float64) float64 {
+	// Writing Log(Gamma(z)) = (z-1/2) Log(z) - z + Log(2π)/2 + stirling_err(z),
+	//
+	//	x**a y**b / Beta(a, b) = Sqrt(ab / (2π(a+b))) * Exp(a Log(x(a+b)/a) + b Log(y(a+b)/b)) *
+	//	                         Exp(stirling_err(a+b) - stirling_err(a) - stirling_err(b))
+	//
+	// and the logarithms are computed relative to the mean x0 = a/(a+b), which is
+	// where the exponent is stationary.
+	var l float64
+	if d := x*b - y*a; math.Abs(d) < a/2 && math.Abs(d) < b/2 {
+		l = a*math.Log1p(d/a) + b*math.Log1p(-d/b)
+	} else {
+		l = a*(math.Log(x)+math.Log1p(b/a)) + b*(math.Log(y)+math.Log1p(a/b))
+	}
+	l += stirling_err(a+b) - stirling_err(a) - stirling_err(b)
+	return math.Sqrt(a/(2*math.Pi)*(b/(a+b))) * math.Exp(l)
+}
+
+// stirling_err returns the error in Stirling's approximation to Log(Gamma(z)) for z > 0, i.e.
+//
+//	stirling_err(z) = Log(Gamma(z)) - (z-1/2) Log(z) + z - Log(2π)/2
+func stirling_err(z float64) float64 {
+	if z < 15 {
+		lg, _ := math.Lgamma(z)
+		return lg - (z-0.5)*math.Log(z) + z - math.Log(2*math.Pi)/2
+	}
+
+	// Asymptotic series in the Bernoulli numbers. See 6.1.41, p257, Abramowitz & Stegun.
+	w := 1 / (z * z)
+	return poly(w, lgamma_asymptotic_coefficients...) / z
+}
@@ -0,0 +1,56 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestBetaRegI(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, Out float64
+	}{
+		{nan, 1, 0.5, nan},
+		{1, nan, 0.5, nan},
+		{1, 1, nan, nan},
+		{-1, 1, 0.5, nan},
+		{1, 0, 0.5, nan},
+		{1, 1, -0.1, nan},
+		{1, 1, 1.1, nan},
+		{inf, inf, 0.5, nan},
+		{2, 3, 0, 0},
+		{2, 3, 1, 1},
+		{inf, 3, 0.5, 0},
+		{2, inf, 0.5, 1},
+		{1, 1, 0.3, 0.29999999999999999},
+		{2, 3, 0.4, 0.52480000000000004},
+		{0.5, 0.5, 0.1, 0.20483276469913345},
+		{0.5, 0.5, 0.9, 0.79516723530086653},
+		{10, 20, 0.2, 0.049263517304212516},
+		{10, 20, 0.5, 0.96928582713007927},
+		{0.1, 5, 0.01, 0.76908892078434632},
+		{5, 0.1, 0.99, 0.23091107921565365},
+		{100, 200, 0.3, 0.10884306564490975},
+		{100, 200, 0.35, 0.73257146495754411},
+		{1000, 1000, 0.49, 0.18555265943151145},
+		{1000, 1000, 0.52, 0.96322051672136044},
+		{1e5, 1e5, 0.501, 0.81445325544244329},
+		{1e6, 2e6, 0.3333, 0.45131470497840598},
+		{1e6, 2e6, 0.3336, 0.836407910421749},
+		{1e-3, 1e-3, 0.5, 0.5},
+		{50, 0.5, 0.9, 0.001204149832559813},
+		{3, 1e4, 1e-4, 0.080328990865353556},
+		{2.5, 7.5, 0.999, 1},
+		{1e-5, 2, 1e-10, 0.9997797656958356},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := BetaRegI(c.In1, c.In2, c.In3)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
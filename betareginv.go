@@ -0,0 +1,164 @@
+package special
+
+import "math"
+
+// The initial estimates used by BetaRegIInv and BetaRegIInvC follow:
+// W. H. Press, S. A. Teukolsky, W. T. Vetterling and B. P. Flannery. Numerical Recipes:
+// The Art of Scientific Computing, 3rd edition, section 6.4. Cambridge University Press (2007).
+
+// BetaRegIInv returns the inverse of the regularised incomplete beta function
+// with respect to x, i.e. the value of x satisfying
+//
+//	BetaRegI(a, b, x) = p
+//
+// for a, b > 0 and 0 ≤ p ≤ 1.
+//
+// See http://mathworld.wolfram.com/RegularizedBetaFunction.html
+// for more information.
+func BetaRegIInv(a, b, p float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(a) || math.IsNaN(b) || math.IsNaN(p) || a <= 0 || b <= 0 || p < 0 || p > 1:
+		return math.NaN()
+	case math.IsInf(a, 1) || math.IsInf(b, 1):
+		return math.NaN()
+	case p == 0:
+		return 0
+	case p == 1:
+		return 1
+	}
+
+	x, _ := betaRegIInv(a, b, p, 1-p)
+	return x
+}
+
+// BetaRegIInvC returns the inverse of the complement of the regularised incomplete beta
+// function with respect to x, i.e. the value of x satisfying
+//
+//	1 - BetaRegI(a, b, x) = BetaRegI(b, a, 1-x) = q
+//
+// for a, b > 0 and 0 ≤ q ≤ 1.
+//
+// See http://mathworld.wolfram.com/RegularizedBetaFunction.html
+// for more information.
+func BetaRegIInvC(a, b, q float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(a) || math.IsNaN(b) || math.IsNaN(q) || a <= 0 || b <= 0 || q < 0 || q > 1:
+		return math.NaN()
+	case math.IsInf(a, 1) || math.IsInf(b, 1):
+		return math.NaN()
+	case q == 0:
+		return 1
+	case q == 1:
+		return 0
+	}
+
+	x, _ := betaRegIInv(a, b, 1-q, q)
+	return x
+}
+
+// betaRegIInv returns x and y = 1-x such that BetaRegI(a, b, x) = p and BetaRegI(b, a, y) = q,
+// where p + q = 1. The smaller of x and y is refined, using the symmetry relation.
+func betaRegIInv(a, b, p, q float64) (float64, float64) {
+	x, y := betaRegIInv_estimate(a, b, p, q)
+	if y < x {
+		y, x = betaRegIInv_halley(b, a, q, p, y)
+		return x, y
+	}
+	return betaRegIInv_halley(a, b, p, q, x)
+}
+
+// betaRegIInv_halley returns x and y = 1-x such that BetaRegI(a, b, x) = p and
+// BetaRegI(b, a, y) = q, where p + q = 1, using Halley's method from the initial estimate x,
+// which is the smaller of x and y. The iteration is in t = Log(x) so that it converges when x
+// is tiny, e.g. when a is small and BetaRegI(a, b, x) ~ x**a.
+func betaRegIInv_halley(a, b, p, q, x float64) (float64, float64) {
+	const (
+		maxiter = 100
+		rtol    = 1e-15
+	)
+
+	for i := 0; i < maxiter; i++ {
+		if x == 0 {
+			// The solution underflows.
+			return 0, 1
+		}
+		y := 1 - x
+
+		// The derivative of BetaRegI with respect to t.
+		dp := betaI_power(a, b, x, y) / y
+		if dp == 0 || math.IsInf(dp, 0) || math.IsNaN(dp) {
+			break
+		}
+
+		// The residual is computed from the smaller of p and q, unless q is smaller and the
+		// rounding error ε in y = 1-x, which gives an error of ~ ε in x, dominates the error
+		// ~ ε (p-q) / (dp/x) from using the direct residual.
+		var r float64
+		if q < p && dp < (p-q)*x {
+			r = q - BetaRegI(b, a, y)
+		} else {
+			r = BetaRegI(a, b, x) - p
+		}
+
+		// Halley step, using d2p/dt2 = dp * (a - (b-1) x/y), damped so that x stays below 1.
+		u := r / dp
+		dt := u / (1 - math.Min(1, u*(a-(b-1)*x/y))/2)
+		xnew := x * math.Exp(-dt)
+		if xnew >= 1 {
+			xnew = (x + 1) / 2
+		}
+		x = xnew
+		if math.Abs(dt) < rtol {
+			break
+		}
+	}
+	return x, 1 - x
+}
+
+// betaRegIInv_estimate returns initial estimates of x and y = 1-x such that BetaRegI(a, b, x) = p
+// and BetaRegI(b, a, y) = q, where p + q = 1.
+func betaRegIInv_estimate(a, b, p, q float64) (float64, float64) {
+	if a >= 1 && b >= 1 {
+		// Normal approximation, using the rational approximation to the inverse of the
+		// normal distribution. See 26.2.23, p933 and 26.5.22, p945, Abramowitz & Stegun.
+		t := math.Sqrt(-2 * math.Log(math.Min(p, q)))
+		s := t - poly(t, 2.30753, 0.27061)/poly(t, 1, 0.99229, 0.04481)
+		if p > q {
+			s = -s
+		}
+		l := (s*s - 3) / 6
+		h := 2 / (1/(2*a-1) + 1/(2*b-1))
+		w := s*math.Sqrt(h+l)/h - (1/(2*b-1)-1/(2*a-1))*(l+5./6-2/(3*h))
+		e := b * math.Exp(2*w)
+		x, y := a/(a+e), e/(a+e)
+
+		// The approximation is poor in the tails, so the smaller of x and y is bounded below
+		// using the leading terms x**a / (a Beta(a, b)) ≥ p and y**b / (b Beta(a, b)) ≥ q,
+		// which hold for a, b ≥ 1.
+		lb, _ := LgammaRatio([]float64{a, b}, []float64{a + b})
+		if p < q {
+			if xt := math.Exp((math.Log(a*p) + lb) / a); x < xt {
+				x, y = xt, 1-xt
+			}
+		} else if yt := math.Exp((math.Log(b*q) + lb) / b); y < yt {
+			x, y = 1-yt, yt
+		}
+		return x, y
+	}
+
+	// Approximate BetaRegI by its leading behaviour near x = 0 and x = 1, i.e.
+	// x**a / (a Beta(a, b)) and 1 - (1-x)**b / (b Beta(a, b)) respectively, using
+	// t and u below as estimates of the values at the crossover.
+	lab := math.Log(a + b)
+	t := math.Exp(a*(math.Log(a)-lab)) / a
+	u := math.Exp(b*(math.Log(b)-lab)) / b
+	w := t + u
+	if p < t/w {
+		x := math.Pow(a*w*p, 1/a)
+		return x, 1 - x
+	}
+	y := math.Pow(b*w*q, 1/b)
+	return 1 - y, y
+}
@@ -0,0 +1,127 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestBetaRegIInv(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, Out float64
+	}{
+		{nan, 1, 0.5, nan},
+		{1, nan, 0.5, nan},
+		{1, 1, nan, nan},
+		{0, 1, 0.5, nan},
+		{1, -1, 0.5, nan},
+		{inf, 1, 0.5, nan},
+		{1, 1, -0.1, nan},
+		{1, 1, 1.1, nan},
+		{2, 3, 0, 0},
+		{2, 3, 1, 1},
+		{1, 1, 0.3, 0.29999999999999999},
+		{2, 3, 0.5, 0.38572756813238956},
+		{0.5, 0.5, 0.1, 0.024471741852423217},
+		{0.5, 0.5, 0.9, 0.97552825814757682},
+		{10, 20, 0.05, 0.2004956976494994},
+		{10, 20, 0.99, 0.54221636320281408},
+		{0.1, 5, 0.7, 0.0038153024076161722},
+		{5, 0.1, 0.01, 0.70649305780429761},
+		{100, 200, 1e-10, 0.17955527642870817},
+		{1000, 1000, 0.6, 0.5028328380732392},
+		{0.01, 2, 0.9, 9.8201424638218513e-06},
+		{3, 10000, 0.5, 0.00026734355227181028},
+		{2.5, 7.5, 1e-50, 1.9659768305882597e-21},
+		{0.01, 1, 0.5, 7.8886090522101181e-31},
+		{0.001, 0.05, 0.5, 3.4334464374471386e-293},
+		{0.02, 0.5, 0.5, 3.4409422718103753e-15},
+		{10, 1, 1e-20, 0.01},
+		{100, 1, 1e-20, 0.63095734448019325},
+		{1, 100, 1e-200, 1e-202},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := BetaRegIInv(c.In1, c.In2, c.In3)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBetaRegIInvC(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, Out float64
+	}{
+		{nan, 1, 0.5, nan},
+		{1, nan, 0.5, nan},
+		{1, 1, nan, nan},
+		{0, 1, 0.5, nan},
+		{1, -1, 0.5, nan},
+		{inf, 1, 0.5, nan},
+		{1, 1, -0.1, nan},
+		{1, 1, 1.1, nan},
+		{2, 3, 0, 1},
+		{2, 3, 1, 0},
+		{1, 1, 0.3, 0.70000000000000007},
+		{2, 3, 0.5, 0.38572756813238956},
+		{0.5, 0.5, 0.1, 0.97552825814757682},
+		{0.5, 0.5, 0.9, 0.024471741852423203},
+		{10, 20, 0.05, 0.47901191000697352},
+		{10, 20, 0.99, 0.15650683607197227},
+		{0.1, 5, 0.7, 7.8659449394544732e-07},
+		{5, 0.1, 0.01, 1},
+		{100, 200, 1e-10, 0.51525910002748032},
+		{1000, 1000, 0.6, 0.49716716192676075},
+		{0.01, 2, 0.9, 3.697112123291128e-101},
+		{3, 10000, 0.5, 0.00026734355227181028},
+		{2.5, 7.5, 1e-50, 0.99999985496405686},
+		{0.01, 1, 0.5, 7.8886090522101181e-31},
+		{0.001, 0.05, 0.5, 3.4334464374471386e-293},
+		{0.5, 0.02, 0.5, 0.99999999999999656},
+		{1, 100, 1e-20, 0.36904265551980675},
+		{1, 10, 1e-20, 0.99},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := BetaRegIInvC(c.In1, c.In2, c.In3)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBetaRegIInvRoundTrip(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3 float64
+	}{
+		{0.01, 1, 0.5},
+		{0.001, 0.05, 0.5},
+		{0.02, 0.5, 0.3},
+		{0.01, 0.01, 0.3},
+		{0.05, 3, 0.999},
+		{0.1, 0.2, 1e-20},
+		{3, 0.5, 1e-8},
+		{100, 2.5, 1e-20},
+		{2.5, 100, 1e-300},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := BetaRegI(c.In1, c.In2, BetaRegIInv(c.In1, c.In2, c.In3))
+			ok := equalFloat64(res, c.In3)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.In3)
+			}
+			res = 1 - BetaRegI(c.In1, c.In2, BetaRegIInvC(c.In1, c.In2, 1-c.In3))
+			ok = equalFloat64(res, 1-c.In3)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, 1-c.In3)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package special
+
+import "math"
+
+// BoseEinstein returns the complete Bose-Einstein integral of order j, defined by
+//
+//	                                         ∞
+//	BoseEinstein(j, η) = [1 / Gamma(j+1)] ∫ dt t**j / (Exp(t-η) - 1)
+//	                                        t=0
+//
+// for j > -1 and η < 0, and by analytic continuation, BoseEinstein(j, η) = Polylog(j+1, Exp(η)),
+// for all real j and η ≤ 0. The normalisation is such that d/dη BoseEinstein(j, η) =
+// BoseEinstein(j-1, η), and BoseEinstein(j, 0) = Zeta(j+1) for j > 0.
+//
+// See 25.12(iii), Digital Library of Mathematical Functions (https://dlmf.nist.gov/25.12#iii)
+// for more information.
+func BoseEinstein(j, eta float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(j) || math.IsNaN(eta) || math.IsInf(j, 0) || eta > 0:
+		return math.NaN()
+	case math.IsInf(eta, -1):
+		return 0
+	case eta == 0:
+		if j > 0 {
+			return Zeta(j + 1)
+		}
+		return math.Inf(1)
+	}
+
+	const etamin = -1
+
+	if eta < etamin {
+		return bose_einstein_series(j, eta, 0)
+	}
+	// The series in Log(Exp(η)) = η of the polylogarithm, in terms of Zeta(j+1-k).
+	return real(polylog_log_series(j+1, complex(eta, 0)))
+}
+
+// BoseEinsteinInc returns the incomplete Bose-Einstein integral of order j, defined by
+//
+//	                                               ∞
+//	BoseEinsteinInc(j, η, b) = [1 / Gamma(j+1)] ∫ dt t**j / (Exp(t-η) - 1)
+//	                                              t=b
+//
+// for j > -1, η ≤ 0 and b ≥ 0, such that BoseEinsteinInc(j, η, 0) = BoseEinstein(j, η).
+//
+// See 25.12(iii), Digital Library of Mathematical Functions (https://dlmf.nist.gov/25.12#iii)
+// for more information.
+func BoseEinsteinInc(j, eta, b float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(j) || math.IsNaN(eta) || math.IsNaN(b) || math.IsInf(j, 0) || j <= -1 || b < 0 || eta > 0:
+		return math.NaN()
+	case b == 0:
+		return BoseEinstein(j, eta)
+	case math.IsInf(b, 1) || math.IsInf(eta, -1):
+		return 0
+	}
+
+	// The series converges like Exp(-k(b-η)), so for b-η < 1 the integral over
+	// [b, 1+η] is computed separately, where 1 / (Exp(t-η) - 1) is expanded in powers of t-η.
+	c := -eta
+	if b+c >= 1 {
+		return bose_einstein_series(j, eta, b)
+	}
+	b1 := 1 - c
+	lg, _ := math.Lgamma(j + 1)
+	return bose_einstein_series(j, eta, b1) + bose_einstein_segment(j, c, b, b1)*math.Exp(-lg)
+}
+
+// bose_einstein_series returns BoseEinsteinInc(j, η, b) for b-η > 0 using the series
+//
+//	                           ∞
+//	BoseEinsteinInc(j, η, b) = ∑ Exp(kη) / k**(j+1) GammaRegQ(j+1, kb)
+//	                          k=1
+//
+// obtained by expanding 1 / (Exp(t-η) - 1) in powers of Exp(η-t) and integrating term by term.
+// For b = 0 it is the series of BoseEinstein(j, η), which holds for all real j.
+func bose_einstein_series(j, eta, b float64) float64 {
+	const (
+		maxiter = 1000
+		tol     = 1e-17
+	)
+
+	res := 0.0
+	for k := 1.0; k < maxiter; k++ {
+		term := math.Exp(-k*(b-eta) - (j+1)*math.Log(k))
+		if b > 0 {
+			term *= gammaQ_scaled(j+1, k*b)
+		}
+		res += term
+		if term < tol*res {
+			break
+		}
+	}
+	return res
+}
+
+// bose_einstein_segment returns the integral
+//
+//	 b1
+//	 ∫ dt t**j / (Exp(t+c) - 1)
+//	t=b
+//
+// for j > -1, c ≥ 0 and 0 < b < b1 ≤ 1-c, using the series
+//
+//	                     ∞
+//	1 / (Exp(y) - 1) = ∑ B(n) y**(n-1) / n!
+//	                    n=0
+//
+// where B(n) is the nth Bernoulli number, integrated term by term with y = t+c.
+func bose_einstein_segment(j, c, b, b1 float64) float64 {
+	const tol = 1e-17
+
+	// powdiff returns (b1**p - b**p) / p.
+	powdiff := func(p float64) float64 {
+		return (math.Pow(b1, p) - math.Pow(b, p)) / p
+	}
+
+	res := bose_einstein_d0(j, c, b, b1) - powdiff(j+1)/2
+	for k, bk := range bernoulli_coefficients {
+		// ∫ dt t**j (t+c)**(2k+1) over [b, b1], expanding (t+c)**(2k+1) binomially.
+		m := 2*k + 1
+		sum := 0.0
+		binom := 1.0
+		for i := 0; i <= m; i++ {
+			sum += binom * math.Pow(c, float64(m-i)) * powdiff(j+1+float64(i))
+			binom *= float64(m-i) / float64(i+1)
+		}
+		term := bk * sum
+		res += term
+		if math.Abs(term) < tol*math.Abs(res) {
+			break
+		}
+	}
+	return res
+}
+
+// bose_einstein_d0 returns the integral
+//
+//	 b1
+//	 ∫ dt t**j / (t+c)
+//	t=b
+//
+// for j > -1, c ≥ 0 and 0 < b < b1.
+func bose_einstein_d0(j, c, b, b1 float64) float64 {
+	switch {
+	case c == 0 && j == 0:
+		return math.Log(b1 / b)
+	case c == 0:
+		return (math.Pow(b1, j) - math.Pow(b, j)) / j
+	case c < b/2:
+		// The terms in c**j of the expansions of each integral in powers of c/b cancel.
+		return bose_einstein_i0_large(j, c, b1, false) - bose_einstein_i0_large(j, c, b, false)
+	case c < b1/2:
+		return bose_einstein_i0_large(j, c, b1, true) - bose_einstein_i0_small(j, c, b)
+	}
+	return bose_einstein_i0_small(j, c, b1) - bose_einstein_i0_small(j, c, b)
+}
+
+// bose_einstein_i0_small returns the integral
+//
+//	 x
+//	 ∫ dt t**j / (t+c) = c**j ∑ Poch(j+1, m) / m! w**(j+1+m) / (j+1+m)
+//	t=0                       m
+//
+// where w = x / (x+c), for j > -1 and c ≥ x/2 > 0.
+func bose_einstein_i0_small(j, c, x float64) float64 {
+	const (
+		maxiter = 500
+		tol     = 1e-17
+	)
+
+	w := x / (x + c)
+	res := 0.0
+	p := 1.0 // Poch(j+1, m) / m! w**m
+	for m := 0.0; m < maxiter; m++ {
+		term := p / (j + 1 + m)
+		res += term
+		if term < tol*res {
+			break
+		}
+		p *= (j + 1 + m) / (m + 1) * w
+	}
+	return math.Pow(c, j) * math.Pow(w, j+1) * res
+}
+
+// bose_einstein_i0_large returns the integral
+//
+//	 x
+//	 ∫ dt t**j / (t+c) = -π c**j / Sin(πj) + ∑ (-c)**m x**(j-m) / (j-m)
+//	t=0                                      m
+//
+// for j > -1, j ≠ 0, 1, 2, ... and 0 < c < x/2. For integer j, the term in c**j is replaced by
+// (-c)**j Log(x/c). The term in c**j is omitted if whole is false.
+func bose_einstein_i0_large(j, c, x float64, whole bool) float64 {
+	const (
+		maxiter = 500
+		tol     = 1e-17
+	)
+
+	isint := j == math.Trunc(j)
+	res := 0.0
+	p := math.Pow(x, j) // (-c)**m x**(j-m)
+	for m := 0.0; m < maxiter; m++ {
+		var term float64
+		if m == j {
+			if whole {
+				term = p * math.Log(x/c)
+			} else {
+				term = p * math.Log(x)
+			}
+		} else {
+			term = p / (j - m)
+		}
+		res += term
+		if m > j && math.Abs(term) < tol*math.Abs(res) {
+			break
+		}
+		p *= -c / x
+	}
+	if whole && !isint {
+		res -= math.Pi * math.Pow(c, j) / sinPi(j)
+	}
+	return res
+}
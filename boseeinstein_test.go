@@ -0,0 +1,66 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestBoseEinstein(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, -1, nan},
+		{0.5, nan, nan},
+		{0.5, 1, nan},
+		{0.5, -inf, 0},
+		{1, 0, 1.6449340668482264},
+		{-0.5, 0, inf},
+		{0.5, -3, 0.05068798629846625},
+		{0.5, -0.5, 0.8104904523267292},
+		{1.5, -0.01, 1.3176537924769074},
+		{2, -0.2, 0.935919959890231},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := BoseEinstein(c.In1, c.In2)
+			if !equalFloat64(res, c.Out) {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBoseEinsteinInc(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, Out float64
+	}{
+		{nan, -1, 1, nan},
+		{0.5, nan, 1, nan},
+		{0.5, -1, nan, nan},
+		{-1, -1, 1, nan},
+		{0.5, 1, 1, nan},
+		{0.5, -1, -1, nan},
+		{0.5, -1, inf, 0},
+		{0.5, -3, 0, 0.05068798629846625},
+		{0.5, -0.5, 0.3, 0.6809723532823713},
+		{0.5, -0.5, 2, 0.16490808895824735},
+		{1.5, -0.01, 0.01, 1.3174766604873795},
+		{1.5, -0.01, 0.5, 1.173805882669918},
+		{-0.5, -0.2, 0.1, 1.162573162466594},
+		{0, -0.3, 0.5, 0.5966176791889755},
+		{2, 0, 0.2, 1.192706910561715},
+		{0.5, 0, 0.01, 2.387075265529377},
+		{1, -0.7, 0.1, 0.573169512865991},
+		{3.5, -2, 1, 0.1349317219129447},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := BoseEinsteinInc(c.In1, c.In2, c.In3)
+			if !equalFloat64(res, c.Out) {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
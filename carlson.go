@@ -0,0 +1,203 @@
+package special
+
+import "math"
+
+// The implementations of CarlsonRF, CarlsonRD, CarlsonRJ and CarlsonRC follow the duplication
+// algorithms of:
+// B. C. Carlson. Numerical computation of real or complex elliptic integrals.
+// Numerical Algorithms 10, 13–26 (1995). arXiv:math/9409227 [math.CA]
+
+// carlson_tol is the relative error bound r of the duplication algorithms.
+const carlson_tol = 1e-16
+
+// CarlsonRF returns Carlson's symmetric elliptic integral of the first kind, defined by
+//
+//	                         ∞
+//	CarlsonRF(x, y, z) = 1/2 ∫ dt / Sqrt((t+x) (t+y) (t+z))
+//	                        t=0
+//
+// for x, y, z ≥ 0 with at most one of them zero.
+//
+// See https://dlmf.nist.gov/19.16 for more information.
+func CarlsonRF(x, y, z float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsNaN(y) || math.IsNaN(z) || x < 0 || y < 0 || z < 0:
+		return math.NaN()
+	case math.IsInf(x, 1) || math.IsInf(y, 1) || math.IsInf(z, 1):
+		return 0
+	case x == 0 && y == 0, x == 0 && z == 0, y == 0 && z == 0:
+		return math.Inf(1)
+	}
+
+	a0 := (x + y + z) / 3
+	a := a0
+	q := math.Pow(3*carlson_tol, -1./6) * math.Max(math.Abs(a0-x), math.Max(math.Abs(a0-y), math.Abs(a0-z)))
+	f := 1.0
+	xm, ym, zm := x, y, z
+	for f*q >= math.Abs(a) {
+		sx, sy, sz := math.Sqrt(xm), math.Sqrt(ym), math.Sqrt(zm)
+		lambda := sx*sy + sx*sz + sy*sz
+		xm, ym, zm, a = (xm+lambda)/4, (ym+lambda)/4, (zm+lambda)/4, (a+lambda)/4
+		f /= 4
+	}
+
+	X := (a0 - x) * f / a
+	Y := (a0 - y) * f / a
+	Z := -(X + Y)
+	e2 := X*Y - Z*Z
+	e3 := X * Y * Z
+	return (1 - e2/10 + e3/14 + e2*e2/24 - 3*e2*e3/44) / math.Sqrt(a)
+}
+
+// CarlsonRD returns Carlson's symmetric elliptic integral of the second kind, defined by
+//
+//	                         ∞
+//	CarlsonRD(x, y, z) = 3/2 ∫ dt / Sqrt((t+x) (t+y) (t+z)**3)
+//	                        t=0
+//
+// for x, y ≥ 0 with at most one of them zero and z > 0.
+//
+// See https://dlmf.nist.gov/19.16 for more information.
+func CarlsonRD(x, y, z float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsNaN(y) || math.IsNaN(z) || x < 0 || y < 0 || z < 0:
+		return math.NaN()
+	case math.IsInf(x, 1) || math.IsInf(y, 1) || math.IsInf(z, 1):
+		return 0
+	case z == 0 || x == 0 && y == 0:
+		return math.Inf(1)
+	}
+
+	a0 := (x + y + 3*z) / 5
+	a := a0
+	q := math.Pow(carlson_tol/4, -1./6) * math.Max(math.Abs(a0-x), math.Max(math.Abs(a0-y), math.Abs(a0-z)))
+	f := 1.0
+	sum := 0.0
+	xm, ym, zm := x, y, z
+	for f*q >= math.Abs(a) {
+		sx, sy, sz := math.Sqrt(xm), math.Sqrt(ym), math.Sqrt(zm)
+		lambda := sx*sy + sx*sz + sy*sz
+		sum += f / (sz * (zm + lambda))
+		xm, ym, zm, a = (xm+lambda)/4, (ym+lambda)/4, (zm+lambda)/4, (a+lambda)/4
+		f /= 4
+	}
+
+	X := (a0 - x) * f / a
+	Y := (a0 - y) * f / a
+	Z := -(X + Y) / 3
+	xy, z2 := X*Y, Z*Z
+	e2 := xy - 6*z2
+	e3 := (3*xy - 8*z2) * Z
+	e4 := 3 * (xy - z2) * z2
+	e5 := xy * z2 * Z
+	return f/(a*math.Sqrt(a))*carlson_series(e2, e3, e4, e5) + 3*sum
+}
+
+// CarlsonRJ returns Carlson's symmetric elliptic integral of the third kind, defined by
+//
+//	                            ∞
+//	CarlsonRJ(x, y, z, p) = 3/2 ∫ dt / [(t+p) Sqrt((t+x) (t+y) (t+z))]
+//	                           t=0
+//
+// for x, y, z ≥ 0 with at most one of them zero and p ≠ 0. For p < 0 the integral is singular
+// and CarlsonRJ returns its Cauchy principal value.
+//
+// See https://dlmf.nist.gov/19.16 for more information.
+func CarlsonRJ(x, y, z, p float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsNaN(y) || math.IsNaN(z) || math.IsNaN(p) || x < 0 || y < 0 || z < 0:
+		return math.NaN()
+	case math.IsInf(x, 1) || math.IsInf(y, 1) || math.IsInf(z, 1) || math.IsInf(p, 0):
+		return 0
+	case p == 0, x == 0 && y == 0, x == 0 && z == 0, y == 0 && z == 0:
+		return math.Inf(1)
+	case p < 0:
+		// Cauchy principal value, from Carlson's transformation to q > 0 with x ≤ y ≤ z.
+		xt := math.Min(x, math.Min(y, z))
+		zt := math.Max(x, math.Max(y, z))
+		yt := x + y + z - xt - zt
+		a := 1 / (yt - p)
+		b := a * (zt - yt) * (yt - xt)
+		q := yt + b
+		rho := xt * zt / yt
+		tau := p * q / yt
+		return a * (b*CarlsonRJ(xt, yt, zt, q) + 3*(CarlsonRC(rho, tau)-CarlsonRF(xt, yt, zt)))
+	}
+
+	a0 := (x + y + z + 2*p) / 5
+	a := a0
+	q := math.Pow(carlson_tol/4, -1./6) *
+		math.Max(math.Max(math.Abs(a0-x), math.Abs(a0-y)), math.Max(math.Abs(a0-z), math.Abs(a0-p)))
+	f := 1.0
+	sum := 0.0
+	xm, ym, zm, pm := x, y, z, p
+	for f*q >= math.Abs(a) {
+		sx, sy, sz, sp := math.Sqrt(xm), math.Sqrt(ym), math.Sqrt(zm), math.Sqrt(pm)
+		lambda := sx*sy + sx*sz + sy*sz
+		d := (sp + sx) * (sp + sy) * (sp + sz)
+		// The argument 1 + (p-x)(p-y)(p-z)/d**2 of CarlsonRC, rewritten to avoid cancellation.
+		sum += f / d * CarlsonRC(1, 2*sp*(pm+lambda)/d)
+		xm, ym, zm, pm, a = (xm+lambda)/4, (ym+lambda)/4, (zm+lambda)/4, (pm+lambda)/4, (a+lambda)/4
+		f /= 4
+	}
+
+	X := (a0 - x) * f / a
+	Y := (a0 - y) * f / a
+	Z := (a0 - z) * f / a
+	P := -(X + Y + Z) / 2
+	p2 := P * P
+	e2 := X*Y + X*Z + Y*Z - 3*p2
+	e3 := X*Y*Z + 2*e2*P + 4*p2*P
+	e4 := (2*X*Y*Z + e2*P + 3*p2*P) * P
+	e5 := X * Y * Z * p2
+	return f/(a*math.Sqrt(a))*carlson_series(e2, e3, e4, e5) + 6*sum
+}
+
+// CarlsonRC returns Carlson's degenerate elliptic integral, defined by
+//
+//	                      ∞
+//	CarlsonRC(x, y) = 1/2 ∫ dt / [(t+y) Sqrt(t+x)] = CarlsonRF(x, y, y)
+//	                     t=0
+//
+// for x ≥ 0 and y ≠ 0. For y < 0 the integral is singular and CarlsonRC returns its Cauchy
+// principal value.
+//
+// See https://dlmf.nist.gov/19.2 for more information.
+func CarlsonRC(x, y float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsNaN(y) || x < 0:
+		return math.NaN()
+	case math.IsInf(x, 1) || math.IsInf(y, 0):
+		return 0
+	case y == 0:
+		return math.Inf(1)
+	case y < 0:
+		// Cauchy principal value. See 19.2.20, Digital Library of Mathematical Functions
+		// (https://dlmf.nist.gov/19.2).
+		return math.Sqrt(x/(x-y)) * CarlsonRC(x-y, -y)
+	}
+
+	a0 := (x + 2*y) / 3
+	a := a0
+	q := math.Pow(3*carlson_tol, -1./8) * math.Abs(a0-x)
+	f := 1.0
+	xm, ym := x, y
+	for f*q >= math.Abs(a) {
+		lambda := 2*math.Sqrt(xm)*math.Sqrt(ym) + ym
+		xm, ym, a = (xm+lambda)/4, (ym+lambda)/4, (a+lambda)/4
+		f /= 4
+	}
+
+	s := (y - a0) * f / a
+	return poly(s, 1, 0, 3./10, 1./7, 3./8, 9./22, 159./208, 9./8) / math.Sqrt(a)
+}
+
+// carlson_series returns the truncated series in the elementary symmetric functions e2, ..., e5
+// common to CarlsonRD and CarlsonRJ.
+func carlson_series(e2, e3, e4, e5 float64) float64 {
+	return 1 - 3*e2/14 + e3/6 + 9*e2*e2/88 - 3*e4/22 - 9*e2*e3/52 + 3*e5/26
+}
@@ -0,0 +1,114 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestCarlsonRF(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, Out float64
+	}{
+		{nan, 1, 1, nan},
+		{-1, 1, 1, nan},
+		{inf, 1, 1, 0},
+		{0, 0, 1, inf},
+		{1, 2, 0, 1.3110287771460598},
+		{2, 3, 4, 0.58408284167715174},
+		{0.5, 1e-20, 3, 1.3512866124730738},
+		{1e-05, 1e5, 1, 0.022577445530799555},
+		{1, 1, 1, 1},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := CarlsonRF(c.In1, c.In2, c.In3)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestCarlsonRD(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, Out float64
+	}{
+		{nan, 1, 1, nan},
+		{1, -1, 1, nan},
+		{1, 1, inf, 0},
+		{1, 1, 0, inf},
+		{0, 0, 1, inf},
+		{0, 2, 1, 1.7972103521033884},
+		{2, 3, 4, 0.16510527294261054},
+		{0.001, 10, 0.5, 1.7463989288773356},
+		{0, 1, 1e-08, 299999986.35504776},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := CarlsonRD(c.In1, c.In2, c.In3)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestCarlsonRJ(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, In4, Out float64
+	}{
+		{nan, 1, 1, 1, nan},
+		{1, 1, -1, 1, nan},
+		{1, 1, 1, inf, 0},
+		{1, 1, 1, 0, inf},
+		{0, 0, 1, 1, inf},
+		{1, 1, 1, 1, 1},
+		{0, 1, 2, 3, 0.77688623778582333},
+		{2, 3, 4, 5, 0.14297579667156754},
+		{2, 3, 4, -0.5, 0.24723819703051564},
+		{2, 3, 4, -5, -0.12711230042963911},
+		{1, 2, 3, 1e-06, 8.4120413647140904},
+		{0.5, 1, 1.5, 100, 0.026660738845418485},
+		{1, 2, 3, -0.001, 4.1779448876186347},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := CarlsonRJ(c.In1, c.In2, c.In3, c.In4)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestCarlsonRC(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 1, nan},
+		{-1, 1, nan},
+		{1, inf, 0},
+		{1, 0, inf},
+		{0, -1, 0},
+		{0, 0.25, 3.1415926535897931},
+		{2.25, 2, 0.69314718055994529},
+		{0.25, -2, 0.23104906018664845},
+		{1, 1e-10, 12.206072646115476},
+		{1e10, 1, 0.00012206072646115478},
+		{3, 3, 0.57735026918962573},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := CarlsonRC(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package special
+
+import "math"
+
+// Dawson returns Dawson's integral, defined by
+//
+//	                       x
+//	Dawson(x) = Exp(-x**2) ∫ dt Exp(t**2) = (Sqrt(π)/2) Exp(-x**2) Erfi(x)
+//	                      t=0
+//
+// See http://mathworld.wolfram.com/DawsonsIntegral.html for more information.
+func Dawson(x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case math.IsInf(x, 0):
+		return math.Copysign(0, x)
+	case x == 0:
+		return x
+	}
+
+	// Dawson(x) = (Sqrt(π)/2) Im[Faddeeva(x)] for real x.
+	_, v := faddeeva(x, 0)
+	return math.SqrtPi / 2 * v
+}
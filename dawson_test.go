@@ -0,0 +1,39 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestDawson(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{inf, 0},
+		{-inf, 0},
+		{0, 0},
+		{1e-10, 1e-10},
+		{0.1, 0.09933599239785286},
+		{-0.5, -0.42443638350202229},
+		{0.92413887, 0.54104422463518165},
+		{1, 0.5380795069127684},
+		{2, 0.30134038892379195},
+		{3.5, 0.14962159308075648},
+		{-5, -0.10213407442427684},
+		{10, 0.050253847187598531},
+		{30, 0.016675941401059175},
+		{100, 0.0050002500375093779},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Dawson(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
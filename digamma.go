@@ -20,7 +20,7 @@ func Digamma(x float64) float64 {
 		return math.NaN()
 	}
 
-	const xmin = 5
+	const xmin = 10
 
 	// If |x| < xmin, use the recurrence relation Digamma(x+1) = Digamma(x) + 1/x
 	// to increment x until x >= xmin.
@@ -33,19 +33,10 @@ func Digamma(x float64) float64 {
 
 	// For |x| > xmin, use the Taylor series expansion about x = ±∞.
 
-	const (
-		c0 = -1. / 12
-		c1 = 1. / 120
-		c2 = -1. / 252
-		c3 = 1. / 240
-		c4 = -1. / 132
-		c5 = 691. / 32760
-		c6 = -1. / 12
-	)
 	s := math.Copysign(1, x)
 	x = math.Abs(x)
 	y := 1 / (x * x)
-	res += math.Log(x) - (s/2)/x + y*poly(y, c0, c1, c2, c3, c4, c5, c6)
+	res += math.Log(x) - (s/2)/x + y*poly(y, digamma_asymptotic_coefficients...)
 
 	if s < 0 {
 		res += math.Pi / math.Tan(math.Pi*x)
@@ -53,3 +44,16 @@ func Digamma(x float64) float64 {
 
 	return res
 }
+
+// The coefficients of the asymptotic series for Digamma are
+// digamma_asymptotic_coefficients[n] = -B(2n+2) / (2n+2)
+// where B(n) is the nth Bernoulli number.
+var digamma_asymptotic_coefficients = []float64{
+	-1. / 12,
+	1. / 120,
+	-1. / 252,
+	1. / 240,
+	-1. / 132,
+	691. / 32760,
+	-1. / 12,
+}
@@ -0,0 +1,34 @@
+package special
+
+import "math"
+
+// DirichletBeta returns the Dirichlet beta function, defined by
+//
+//	                   ∞
+//	DirichletBeta(s) = ∑ (-1)**n / (2n+1)**s
+//	                  n=0
+//
+// for s > 0, and by analytic continuation for all real s. In particular, DirichletBeta(1) = π/4
+// and DirichletBeta(2) is Catalan's constant.
+//
+// See http://mathworld.wolfram.com/DirichletBetaFunction.html for more information.
+func DirichletBeta(s float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(s) || math.IsInf(s, -1):
+		return math.NaN()
+	case math.IsInf(s, 1):
+		return 1
+	case s == 0:
+		return 1. / 2
+	case s == 1:
+		return math.Pi / 4
+	case s < 0 && math.Trunc(s) == s && int(s)&1 == 1:
+		return 0
+	}
+
+	return DirichletL(s, dirichlet_beta_chi)
+}
+
+// dirichlet_beta_chi is the non-principal Dirichlet character modulo 4.
+var dirichlet_beta_chi = []float64{0, 1, 0, -1}
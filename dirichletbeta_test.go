@@ -0,0 +1,39 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestDirichletBeta(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{-inf, nan},
+		{inf, 1},
+		{0, 0.5},
+		{1, 0.7853981633974483},
+		{2, Catalan},
+		{3, 0.9689461462593693},
+		{4, 0.9889445517411054},
+		{-1, 0},
+		{-3, 0},
+		{-2, -0.5},
+		{-4, 2.5},
+		{0.5, 0.6676914571896091},
+		{3.5, 0.9814025112714405},
+		{-2.5, -0.47477605327649003},
+		{60, 1},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := DirichletBeta(c.In)
+			if !equalFloat64(res, c.Out) {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
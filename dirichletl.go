@@ -0,0 +1,116 @@
+package special
+
+import "math"
+
+// DirichletL returns the Dirichlet L-function of the periodic sequence χ, defined by
+//
+//	                   ∞
+//	DirichletL(s, χ) = ∑ χ[n mod q] / n**s
+//	                  n=1
+//
+// for s > 1, where q = len(χ) is the period, and by analytic continuation for all real s. The
+// sequence need not be a Dirichlet character. If the sum of χ over a period is zero, DirichletL
+// is finite for all s, otherwise it has a simple pole at s = 1. For example, Zeta(s) =
+// DirichletL(s, []float64{1}) and DirichletBeta(s) = DirichletL(s, []float64{0, 1, 0, -1}).
+//
+// See http://mathworld.wolfram.com/DirichletL-Series.html for more information.
+func DirichletL(s float64, chi []float64) float64 {
+	q := len(chi)
+
+	// Special cases.
+	switch {
+	case math.IsNaN(s) || math.IsInf(s, -1) || q == 0:
+		return math.NaN()
+	case math.IsInf(s, 1):
+		return chi[1%q]
+	}
+
+	if s < 0 {
+		// Sum over the residues n = r mod q, for r = 1, ..., q.
+		fq := float64(q)
+		res := 0.0
+		for r := 1; r <= q; r++ {
+			if c := chi[r%q]; c != 0 {
+				res += c * HurwitzZeta(s, float64(r)/fq)
+			}
+		}
+		return res * math.Pow(fq, -s)
+	}
+	return dirichlet_l_euler_maclaurin(s, chi)
+}
+
+// dirichlet_l_euler_maclaurin returns DirichletL(s, χ) for s ≥ 0 by writing it as
+//
+//	                           q
+//	DirichletL(s, χ) = q**(-s) ∑ χ[r mod q] HurwitzZeta(s, r/q)
+//	                          r=1
+//
+// and applying the Euler-Maclaurin summation formula of hurwitz_zeta_scaled to each term with
+// the same number of terms N. The poles x**(1-s) / (s-1) of each term, where x = N+r/q, are
+// combined as
+//
+//	N**(1-s) [1 + Expm1((1-s) Log(1+r/qN))] / (s-1)
+//
+// so that, when the sum of χ over a period is zero, the leading terms cancel exactly and the
+// remainder is finite at s = 1.
+func dirichlet_l_euler_maclaurin(s float64, chi []float64) float64 {
+	const tol = 1e-17
+
+	q := len(chi)
+	fq := float64(q)
+	n := math.Ceil((s + 40) / math.Pi)
+
+	// The terms with n ≤ Nq, summed directly.
+	res := 0.0
+	for k := 1; k <= int(n)*q; k++ {
+		if c := chi[k%q]; c != 0 {
+			res += c * math.Pow(float64(k), -s)
+		}
+	}
+
+	// The poles of the remainders.
+	sum := 0.0
+	pole := 0.0
+	for r := 1; r <= q; r++ {
+		c := chi[r%q]
+		if c == 0 {
+			continue
+		}
+		sum += c
+		u := math.Log1p(float64(r) / (fq * n))
+		if s == 1 {
+			pole -= c * u
+		} else {
+			pole += c * math.Expm1((1-s)*u) / (s - 1)
+		}
+	}
+	if sum != 0 {
+		pole += sum / (s - 1)
+	}
+	res += pole * n * math.Pow(fq*n, -s)
+
+	// The remaining terms of the Euler-Maclaurin summation formula, where (qx)**(-s) = (qN+r)**(-s).
+	for r := 1; r <= q; r++ {
+		c := chi[r%q]
+		if c == 0 {
+			continue
+		}
+		x := n + float64(r)/fq
+		xs := math.Pow(fq*x, -s)
+		rem := xs / 2
+
+		// tmp = s(s+1)...(s+2k-2) (qx)**(-s) x**(-2k+1).
+		tmp := s * xs / x
+		for k, b := range bernoulli_coefficients {
+			term := b * tmp
+			rem += term
+			if math.Abs(term) < tol*math.Abs(rem) {
+				break
+			}
+			fk := float64(2*k + 2)
+			tmp *= (s + fk - 1) * (s + fk) / (x * x)
+		}
+		res += c * rem
+	}
+	return res
+}
@@ -0,0 +1,41 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestDirichletL(t *testing.T) {
+	cases := []struct {
+		In1 float64
+		In2 []float64
+		Out float64
+	}{
+		{nan, []float64{1}, nan},
+		{-inf, []float64{1}, nan},
+		{2, []float64{}, nan},
+		{inf, []float64{3, 2, 1}, 2},
+		{1, []float64{1}, inf},
+		{1, []float64{-1, 0}, -inf},
+		{2, []float64{1}, 1.6449340668482264},
+		{-1.5, []float64{1}, -0.025485201889833036},
+		{2, []float64{1, -1}, -0.8224670334241132},
+		{2, []float64{0, 1}, 1.2337005501361697},
+		{2, []float64{0, 1, 0, -1}, Catalan},
+		{1, []float64{0, 1, -1}, 0.6045997880780726},
+		{2, []float64{0, 1, -1}, 0.7813024128964862},
+		{0, []float64{0, 1, -1}, 0.3333333333333333},
+		{-2, []float64{0, 1, -1}, -0.2222222222222222},
+		{1, []float64{0, 1, -1, -1, 1}, 0.43040894096400403},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := DirichletL(c.In1, c.In2)
+			if !equalFloat64(res, c.Out) {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
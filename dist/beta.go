@@ -0,0 +1,91 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/scientificgo/special"
+)
+
+// Beta is the beta distribution with shape parameters Alpha > 0 and Beta > 0, with probability
+// density function
+//
+//	PDF(x) = x**(Alpha-1) (1-x)**(Beta-1) / Beta(Alpha, Beta)
+//
+// for 0 ≤ x ≤ 1.
+//
+// See http://mathworld.wolfram.com/BetaDistribution.html for more information.
+type Beta struct {
+	Alpha, Beta float64
+}
+
+func (d Beta) valid() bool {
+	return d.Alpha > 0 && d.Beta > 0 && !math.IsInf(d.Alpha, 1) && !math.IsInf(d.Beta, 1)
+}
+
+// PDF returns the probability density function of the beta distribution.
+func (d Beta) PDF(x float64) float64 {
+	return math.Exp(d.LogPDF(x))
+}
+
+// LogPDF returns the natural logarithm of the probability density function of the beta distribution.
+func (d Beta) LogPDF(x float64) float64 {
+	a, b := d.Alpha, d.Beta
+
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x < 0 || x > 1:
+		return math.Inf(-1)
+	case x == 0 && a < 1, x == 1 && b < 1:
+		return math.Inf(1)
+	case x == 0 && a > 1, x == 1 && b > 1:
+		return math.Inf(-1)
+	}
+
+	lb, _ := special.LgammaRatio([]float64{a, b}, []float64{a + b})
+	res := -lb
+	if a != 1 {
+		res += (a - 1) * math.Log(x)
+	}
+	if b != 1 {
+		res += (b - 1) * math.Log1p(-x)
+	}
+	return res
+}
+
+// CDF returns the cumulative distribution function of the beta distribution.
+func (d Beta) CDF(x float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x <= 0:
+		return 0
+	case x >= 1:
+		return 1
+	}
+	return special.BetaRegI(d.Alpha, d.Beta, x)
+}
+
+// SF returns the survival function of the beta distribution.
+func (d Beta) SF(x float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x <= 0:
+		return 1
+	case x >= 1:
+		return 0
+	}
+	return special.BetaRegI(d.Beta, d.Alpha, 1-x)
+}
+
+// Quantile returns the quantile function of the beta distribution.
+func (d Beta) Quantile(p float64) float64 {
+	if !d.valid() {
+		return math.NaN()
+	}
+	return special.BetaRegIInv(d.Alpha, d.Beta, p)
+}
@@ -0,0 +1,167 @@
+package dist_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special/dist"
+)
+
+func TestBetaPDF(t *testing.T) {
+	cases := []struct {
+		Alpha, Beta, In, Out float64
+	}{
+		{-1, 1, 0.5, nan},
+		{2, 2, 1.5, 0},
+		{0.5, 2, 0, inf},
+		{2, 0.5, 1, inf},
+		{2, 2, 0, 0},
+		{1, 3, 0, 3},
+		{1, 1, 0.7, 1},
+		{2, 3, 0.1, 0.97200000000000009},
+		{2, 3, 0.5, 1.5},
+		{2, 3, 0.9, 0.10799999999999996},
+		{0.5, 0.5, 0.01, 3.1991347258556542},
+		{0.5, 0.5, 0.5, 0.63661977236758138},
+		{0.5, 0.5, 0.999, 10.07087911994709},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Beta{c.Alpha, c.Beta}.PDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBetaLogPDF(t *testing.T) {
+	cases := []struct {
+		Alpha, Beta, In, Out float64
+	}{
+		{1, 0, 0.5, nan},
+		{2, 2, -0.5, -inf},
+		{2, 3, 0.1, -0.028399474521697932},
+		{2, 3, 0.5, 0.40546510810816438},
+		{2, 3, 0.9, -2.2256240518579178},
+		{0.5, 0.5, 0.01, 1.1628803750713963},
+		{0.5, 0.5, 0.5, -0.45158270528945488},
+		{0.5, 0.5, 0.999, 2.3096480038084595},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Beta{c.Alpha, c.Beta}.LogPDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBetaCDF(t *testing.T) {
+	cases := []struct {
+		Alpha, Beta, In, Out float64
+	}{
+		{-1, 1, 0.5, nan},
+		{2, 2, -1, 0},
+		{2, 2, 2, 1},
+		{2, 3, 0.1, 0.052300000000000006},
+		{2, 3, 0.5, 0.6875},
+		{2, 3, 0.9, 0.99629999999999996},
+		{0.5, 0.5, 0.01, 0.063768560858519854},
+		{0.5, 0.5, 0.5, 0.5},
+		{0.5, 0.5, 0.999, 0.97986495836662246},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Beta{c.Alpha, c.Beta}.CDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBetaSF(t *testing.T) {
+	cases := []struct {
+		Alpha, Beta, In, Out float64
+	}{
+		{-1, 1, 0.5, nan},
+		{2, 2, -1, 1},
+		{2, 2, 2, 0},
+		{2, 3, 0.1, 0.94769999999999999},
+		{2, 3, 0.5, 0.3125},
+		{2, 3, 0.9, 0.0036999999999999976},
+		{0.5, 0.5, 0.01, 0.9362314391414801},
+		{0.5, 0.5, 0.5, 0.5},
+		{0.5, 0.5, 0.999, 0.020135041633377499},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Beta{c.Alpha, c.Beta}.SF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBetaQuantile(t *testing.T) {
+	cases := []struct {
+		Alpha, Beta, In, Out float64
+	}{
+		{-1, 1, 0.5, nan},
+		{2, 2, 0, 0},
+		{2, 2, 1, 1},
+		{2, 3, 1e-10, 4.0824940158083332e-06},
+		{2, 3, 0.01, 0.041998635621700711},
+		{2, 3, 0.3, 0.27238394207510536},
+		{2, 3, 0.5, 0.38572756813238956},
+		{2, 3, 0.9, 0.67953941627818171},
+		{2, 3, 0.999999, 0.99369042632979454},
+		{0.5, 0.5, 1e-10, 2.4674011002723397e-20},
+		{0.5, 0.5, 0.01, 0.00024671981713422151},
+		{0.5, 0.5, 0.3, 0.20610737385376343},
+		{0.5, 0.5, 0.5, 0.5},
+		{0.5, 0.5, 0.9, 0.97552825814757682},
+		{0.5, 0.5, 0.999999, 0.99999999999753264},
+		{10, 1, 1e-20, 0.01},
+		{1, 100, 1e-200, 1e-202},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Beta{c.Alpha, c.Beta}.Quantile(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBetaQuantileRoundTrip(t *testing.T) {
+	cases := []struct {
+		Alpha, Beta, In float64
+	}{
+		{0.02, 0.5, 0.01},
+		{0.02, 0.5, 0.5},
+		{0.01, 0.01, 0.3},
+		{0.05, 3, 1e-10},
+		{0.05, 3, 0.999},
+		{0.5, 0.02, 1e-10},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			d := Beta{c.Alpha, c.Beta}
+			res := d.CDF(d.Quantile(c.In))
+			ok := equalFloat64(res, c.In)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.In)
+			}
+		})
+	}
+}
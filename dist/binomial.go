@@ -0,0 +1,116 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/scientificgo/special"
+)
+
+// Binomial is the binomial distribution of the number of successes in N ≥ 0 independent trials,
+// each with success probability 0 ≤ P ≤ 1, with probability mass function
+//
+//	PDF(k) = Binomial(N, k) P**k (1-P)**(N-k)
+//
+// for integers 0 ≤ k ≤ N.
+//
+// See http://mathworld.wolfram.com/BinomialDistribution.html for more information.
+type Binomial struct {
+	N int
+	P float64
+}
+
+func (d Binomial) valid() bool {
+	return d.N >= 0 && d.P >= 0 && d.P <= 1
+}
+
+// PDF returns the probability mass function of the binomial distribution.
+func (d Binomial) PDF(x float64) float64 {
+	return math.Exp(d.LogPDF(x))
+}
+
+// LogPDF returns the natural logarithm of the probability mass function of the binomial distribution.
+func (d Binomial) LogPDF(x float64) float64 {
+	n, p := float64(d.N), d.P
+
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case !isNonNegInt(x) || x > n:
+		return math.Inf(-1)
+	case p == 0:
+		if x == 0 {
+			return 0
+		}
+		return math.Inf(-1)
+	case p == 1:
+		if x == n {
+			return 0
+		}
+		return math.Inf(-1)
+	}
+
+	lc, _ := special.LgammaRatio([]float64{n + 1}, []float64{x + 1, n - x + 1})
+	return lc + x*math.Log(p) + (n-x)*math.Log1p(-p)
+}
+
+// CDF returns the cumulative distribution function of the binomial distribution.
+func (d Binomial) CDF(x float64) float64 {
+	n := float64(d.N)
+
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x < 0:
+		return 0
+	case x >= n:
+		return 1
+	}
+
+	k := math.Floor(x)
+	return special.BetaRegI(n-k, k+1, 1-d.P)
+}
+
+// SF returns the survival function of the binomial distribution.
+func (d Binomial) SF(x float64) float64 {
+	n := float64(d.N)
+
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x < 0:
+		return 1
+	case x >= n:
+		return 0
+	}
+
+	k := math.Floor(x)
+	return special.BetaRegI(k+1, n-k, d.P)
+}
+
+// Quantile returns the quantile function of the binomial distribution.
+func (d Binomial) Quantile(p float64) float64 {
+	n := float64(d.N)
+
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(p) || p < 0 || p > 1:
+		return math.NaN()
+	case p == 0:
+		return 0
+	case p == 1:
+		return n
+	}
+
+	// Cornish-Fisher estimate. See 26.2.51, p935, Abramowitz & Stegun.
+	z := normalQuantile(p)
+	m := n * d.P
+	s := math.Sqrt(m * (1 - d.P))
+	k0 := m + s*z
+	if s > 0 {
+		k0 += (1 - 2*d.P) * (z*z - 1) / 6
+	}
+	return discreteQuantile(d.CDF, d.SF, p, 1-p, k0, n)
+}
@@ -0,0 +1,155 @@
+package dist_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special/dist"
+)
+
+func TestBinomialPDF(t *testing.T) {
+	cases := []struct {
+		N          int
+		P, In, Out float64
+	}{
+		{-1, 0.5, 1, nan},
+		{10, 1.5, 1, nan},
+		{10, 0.5, 11, 0},
+		{10, 0.5, 2.5, 0},
+		{10, 0, 0, 1},
+		{10, 1, 10, 1},
+		{10, 1, 9, 0},
+		{20, 0.3, 0, 0.00079792266297612025},
+		{20, 0.3, 3, 0.071603672205262328},
+		{20, 0.3, 6.5, 0},
+		{20, 0.3, 15, 3.7389768875292939e-05},
+		{1000, 0.01, 2, 0.00220018754021261},
+		{1000, 0.01, 10, 0.12574021112620737},
+		{1000, 0.01, 25, 2.6442557919022015e-05},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Binomial{c.N, c.P}.PDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBinomialLogPDF(t *testing.T) {
+	cases := []struct {
+		N          int
+		P, In, Out float64
+	}{
+		{10, -0.5, 1, nan},
+		{10, 0.5, -1, -inf},
+		{20, 0.3, 0, -7.1334988787746472},
+		{20, 0.3, 3, -2.6366089185477173},
+		{20, 0.3, 6.5, -inf},
+		{20, 0.3, 15, -10.194113450452155},
+		{1000, 0.01, 2, -6.1192126766998758},
+		{1000, 0.01, 10, -2.0735373169590643},
+		{1000, 0.01, 25, -10.540535803370426},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Binomial{c.N, c.P}.LogPDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBinomialCDF(t *testing.T) {
+	cases := []struct {
+		N          int
+		P, In, Out float64
+	}{
+		{-1, 0.5, 1, nan},
+		{10, 0.5, -1, 0},
+		{10, 0.5, 10, 1},
+		{10, 0, 0, 1},
+		{10, 1, 9, 0},
+		{20, 0.3, 0, 0.00079792266297612025},
+		{20, 0.3, 3, 0.10708680450373102},
+		{20, 0.3, 6.5, 0.60800981220092398},
+		{20, 0.3, 15, 0.99999444974692175},
+		{1000, 0.01, 2, 0.0026794319937915334},
+		{1000, 0.01, 10, 0.5830408033010982},
+		{1000, 0.01, 25, 0.99998441334947064},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Binomial{c.N, c.P}.CDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBinomialSF(t *testing.T) {
+	cases := []struct {
+		N          int
+		P, In, Out float64
+	}{
+		{-1, 0.5, 1, nan},
+		{10, 0.5, -1, 1},
+		{10, 0.5, 10, 0},
+		{20, 0.3, 0, 0.99920207733702393},
+		{20, 0.3, 3, 0.89291319549626902},
+		{20, 0.3, 6.5, 0.39199018779907602},
+		{20, 0.3, 15, 5.5502530782987573e-06},
+		{1000, 0.01, 2, 0.9973205680062085},
+		{1000, 0.01, 10, 0.4169591966989018},
+		{1000, 0.01, 25, 1.5586650529345428e-05},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Binomial{c.N, c.P}.SF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestBinomialQuantile(t *testing.T) {
+	cases := []struct {
+		N          int
+		P, In, Out float64
+	}{
+		{-1, 0.5, 0.5, nan},
+		{10, 0.5, 0, 0},
+		{10, 0.5, 1, 10},
+		{10, 0, 0.5, 0},
+		{10, 1, 0.5, 10},
+		{20, 0.3, 1e-10, 0},
+		{20, 0.3, 0.01, 2},
+		{20, 0.3, 0.3, 5},
+		{20, 0.3, 0.5, 6},
+		{20, 0.3, 0.9, 9},
+		{20, 0.3, 0.999999, 16},
+		{1000, 0.01, 1e-10, 0},
+		{1000, 0.01, 0.01, 3},
+		{1000, 0.01, 0.3, 8},
+		{1000, 0.01, 0.5, 10},
+		{1000, 0.01, 0.9, 14},
+		{1000, 0.01, 0.999999, 28},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Binomial{c.N, c.P}.Quantile(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package dist
+
+// ChiSquared is the chi-squared distribution with K > 0 degrees of freedom, with probability
+// density function
+//
+//	PDF(x) = x**(K/2-1) Exp(-x/2) / (Gamma(K/2) 2**(K/2))
+//
+// for x ≥ 0. It is the gamma distribution with shape K/2 and scale 2.
+//
+// See http://mathworld.wolfram.com/Chi-SquaredDistribution.html for more information.
+type ChiSquared struct {
+	K float64
+}
+
+func (d ChiSquared) gamma() Gamma {
+	return Gamma{Shape: d.K / 2, Scale: 2}
+}
+
+// PDF returns the probability density function of the chi-squared distribution.
+func (d ChiSquared) PDF(x float64) float64 {
+	return d.gamma().PDF(x)
+}
+
+// LogPDF returns the natural logarithm of the probability density function of the chi-squared distribution.
+func (d ChiSquared) LogPDF(x float64) float64 {
+	return d.gamma().LogPDF(x)
+}
+
+// CDF returns the cumulative distribution function of the chi-squared distribution.
+func (d ChiSquared) CDF(x float64) float64 {
+	return d.gamma().CDF(x)
+}
+
+// SF returns the survival function of the chi-squared distribution.
+func (d ChiSquared) SF(x float64) float64 {
+	return d.gamma().SF(x)
+}
+
+// Quantile returns the quantile function of the chi-squared distribution.
+func (d ChiSquared) Quantile(p float64) float64 {
+	return d.gamma().Quantile(p)
+}
@@ -0,0 +1,134 @@
+package dist_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special/dist"
+)
+
+func TestChiSquaredPDF(t *testing.T) {
+	cases := []struct {
+		K, In, Out float64
+	}{
+		{-1, 1, nan},
+		{2, 0, 0.5},
+		{3, 0.5, 0.21969564473386119},
+		{3, 3, 0.15418032980376928},
+		{3, 30, 6.6842620035748993e-07},
+		{50, 30, 0.0041498970502362419},
+		{50, 50, 0.039761475734032721},
+		{50, 100, 9.2644649601261985e-06},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := ChiSquared{c.K}.PDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestChiSquaredLogPDF(t *testing.T) {
+	cases := []struct {
+		K, In, Out float64
+	}{
+		{0, 1, nan},
+		{3, 0.5, -1.5155121234846454},
+		{3, 3, -1.8696323888706179},
+		{3, 30, -14.218339842373595},
+		{50, 30, -5.4846717522192225},
+		{50, 50, -3.2248567818354466},
+		{50, 100, -11.589324448396759},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := ChiSquared{c.K}.LogPDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestChiSquaredCDF(t *testing.T) {
+	cases := []struct {
+		K, In, Out float64
+	}{
+		{-1, 1, nan},
+		{3, -1, 0},
+		{3, 0.5, 0.08110858834532414},
+		{3, 3, 0.60837482372891105},
+		{3, 30, 0.99999861994296868},
+		{50, 30, 0.011164780271550283},
+		{50, 50, 0.52660153144365063},
+		{50, 100, 0.99996545068617015},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := ChiSquared{c.K}.CDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestChiSquaredSF(t *testing.T) {
+	cases := []struct {
+		K, In, Out float64
+	}{
+		{-1, 1, nan},
+		{3, -1, 1},
+		{3, 0.5, 0.9188914116546758},
+		{3, 3, 0.39162517627108895},
+		{3, 30, 1.3800570312932547e-06},
+		{50, 30, 0.98883521972844968},
+		{50, 50, 0.47339846855634937},
+		{50, 100, 3.4549313829848641e-05},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := ChiSquared{c.K}.SF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestChiSquaredQuantile(t *testing.T) {
+	cases := []struct {
+		K, In, Out float64
+	}{
+		{-1, 0.5, nan},
+		{3, 0, 0},
+		{3, 1, inf},
+		{3, 1e-10, 5.2093976214344798e-07},
+		{3, 0.01, 0.11483180189911704},
+		{3, 0.3, 1.4236522430352796},
+		{3, 0.5, 2.3659738843753382},
+		{3, 0.9, 6.2513886311703235},
+		{3, 0.999999, 30.664849706154268},
+		{50, 1e-10, 9.7711148758771262},
+		{50, 0.01, 29.706682698841291},
+		{50, 0.3, 44.313306977323997},
+		{50, 0.5, 49.334936733976832},
+		{50, 0.9, 63.167121005726322},
+		{50, 0.999999, 112.60809249886347},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := ChiSquared{c.K}.Quantile(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Package dist provides univariate probability distributions built on the incomplete gamma and
+// beta functions of package special.
+package dist
+
+import "math"
+
+// Distribution is a univariate probability distribution. For discrete distributions, PDF and
+// LogPDF return the probability mass function and its logarithm, and are zero (-Inf) at
+// non-integer x.
+type Distribution interface {
+	// PDF returns the probability density function at x.
+	PDF(x float64) float64
+
+	// LogPDF returns the natural logarithm of the probability density function at x.
+	LogPDF(x float64) float64
+
+	// CDF returns the cumulative distribution function, i.e. the probability P(X ≤ x).
+	CDF(x float64) float64
+
+	// SF returns the survival function, i.e. the probability P(X > x) = 1 - CDF(x).
+	SF(x float64) float64
+
+	// Quantile returns the inverse of the cumulative distribution function, i.e. the smallest x
+	// such that CDF(x) ≥ p.
+	Quantile(p float64) float64
+}
+
+var (
+	_ Distribution = Beta{}
+	_ Distribution = Binomial{}
+	_ Distribution = ChiSquared{}
+	_ Distribution = F{}
+	_ Distribution = Gamma{}
+	_ Distribution = NegativeBinomial{}
+	_ Distribution = Poisson{}
+	_ Distribution = StudentT{}
+)
+
+// isNonNegInt returns true if x is a non-negative integer.
+func isNonNegInt(x float64) bool {
+	return x >= 0 && x == math.Trunc(x)
+}
+
+// normalQuantile returns the quantile function of the standard normal distribution.
+func normalQuantile(p float64) float64 {
+	return -math.Sqrt2 * math.Erfcinv(2*p)
+}
+
+// discreteQuantile returns the smallest integer 0 ≤ k ≤ kmax such that cdf(k) ≥ p, where
+// p + q = 1 and sf is the survival function, starting from the estimate k0. The smaller of
+// p and q is used for the comparisons.
+func discreteQuantile(cdf, sf func(float64) float64, p, q, k0, kmax float64) float64 {
+	ok := func(k float64) bool {
+		if k >= kmax {
+			return true
+		}
+		if p < q {
+			return cdf(k) >= p
+		}
+		return sf(k) <= q
+	}
+
+	// Bracket the quantile between lo, for which ok is false, and hi, for which ok is true,
+	// by taking steps of increasing size from k0.
+	k := math.Min(math.Max(0, math.Round(k0)), kmax)
+	lo, hi := k-1, k
+	if ok(k) {
+		for step := 1.0; lo >= 0 && ok(lo); step *= 2 {
+			hi = lo
+			lo = math.Max(-1, hi-step)
+		}
+	} else {
+		lo = k
+		for step := 1.0; ; step *= 2 {
+			hi = math.Min(lo+step, kmax)
+			if ok(hi) {
+				break
+			}
+			lo = hi
+		}
+	}
+
+	// Bisection.
+	for hi-lo > 1 {
+		mid := math.Floor(lo + (hi-lo)/2)
+		if ok(mid) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi
+}
@@ -0,0 +1,109 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/scientificgo/special"
+)
+
+// F is the F-distribution (Fisher-Snedecor distribution) with D1 > 0 and D2 > 0 degrees of freedom,
+// with probability density function
+//
+//	PDF(x) = Sqrt((D1 x)**D1 D2**D2 / (D1 x + D2)**(D1+D2)) / (x Beta(D1/2, D2/2))
+//
+// for x ≥ 0.
+//
+// See http://mathworld.wolfram.com/F-Distribution.html for more information.
+type F struct {
+	D1, D2 float64
+}
+
+func (d F) valid() bool {
+	return d.D1 > 0 && d.D2 > 0 && !math.IsInf(d.D1, 1) && !math.IsInf(d.D2, 1)
+}
+
+// PDF returns the probability density function of the F-distribution.
+func (d F) PDF(x float64) float64 {
+	return math.Exp(d.LogPDF(x))
+}
+
+// LogPDF returns the natural logarithm of the probability density function of the F-distribution.
+func (d F) LogPDF(x float64) float64 {
+	a, b := d.D1/2, d.D2/2
+
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x < 0 || math.IsInf(x, 1):
+		return math.Inf(-1)
+	case x == 0:
+		if a < 1 {
+			return math.Inf(1)
+		}
+		if a == 1 {
+			// PDF(0) = 1 for D1 = 2.
+			return 0
+		}
+		return math.Inf(-1)
+	}
+
+	// Writing u = D1 x / (D1 x + D2), PDF(x) = u**(D1/2) (1-u)**(D2/2) / (x Beta(D1/2, D2/2)).
+	dx := d.D1 * x
+	lb, _ := special.LgammaRatio([]float64{a, b}, []float64{a + b})
+	return a*math.Log(dx/(dx+d.D2)) + b*math.Log(d.D2/(dx+d.D2)) - math.Log(x) - lb
+}
+
+// CDF returns the cumulative distribution function of the F-distribution.
+func (d F) CDF(x float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x <= 0:
+		return 0
+	case math.IsInf(x, 1):
+		return 1
+	}
+
+	dx := d.D1 * x
+	return special.BetaRegI(d.D1/2, d.D2/2, dx/(dx+d.D2))
+}
+
+// SF returns the survival function of the F-distribution.
+func (d F) SF(x float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x <= 0:
+		return 1
+	case math.IsInf(x, 1):
+		return 0
+	}
+
+	dx := d.D1 * x
+	return special.BetaRegI(d.D2/2, d.D1/2, d.D2/(dx+d.D2))
+}
+
+// Quantile returns the quantile function of the F-distribution.
+func (d F) Quantile(p float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(p) || p < 0 || p > 1:
+		return math.NaN()
+	case p == 0:
+		return 0
+	case p == 1:
+		return math.Inf(1)
+	}
+
+	// Solve BetaRegI(D1/2, D2/2, u) = p, where u = D1 x / (D1 x + D2) and v = 1-u.
+	a, b := d.D1/2, d.D2/2
+	u := special.BetaRegIInv(a, b, p)
+	v := 1 - u
+	if u > 0.5 {
+		v = special.BetaRegIInvC(b, a, p)
+	}
+	return d.D2 * u / (d.D1 * v)
+}
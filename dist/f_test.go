@@ -0,0 +1,140 @@
+package dist_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special/dist"
+)
+
+func TestFPDF(t *testing.T) {
+	cases := []struct {
+		D1, D2, In, Out float64
+	}{
+		{-1, 1, 1, nan},
+		{2, 2, -1, 0},
+		{1, 2, 0, inf},
+		{2, 5, 0, 1},
+		{3, 5, 0, 0},
+		{3, 7, 0.1, 0.58613926331178634},
+		{3, 7, 1, 0.38425109585377504},
+		{3, 7, 5, 0.016671962040708935},
+		{1, 100, 0.01, 3.9594172444080771},
+		{1, 100, 2, 0.10351437776921044},
+		{1, 100, 15, 8.8417408229083625e-05},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := F{c.D1, c.D2}.PDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestFLogPDF(t *testing.T) {
+	cases := []struct {
+		D1, D2, In, Out float64
+	}{
+		{1, 0, 1, nan},
+		{3, 7, 0.1, -0.53419786693687232},
+		{3, 7, 1, -0.95645904463835074},
+		{3, 7, 5, -4.0940268902426515},
+		{1, 100, 0.01, 1.3760968539342091},
+		{1, 100, 2, -2.2680447602800529},
+		{1, 100, 15, -9.3334416820396235},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := F{c.D1, c.D2}.LogPDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestFCDF(t *testing.T) {
+	cases := []struct {
+		D1, D2, In, Out float64
+	}{
+		{-1, 1, 1, nan},
+		{2, 2, 0, 0},
+		{2, 2, inf, 1},
+		{3, 7, 0.1, 0.042529320181903252},
+		{3, 7, 1, 0.55292038653151643},
+		{3, 7, 5, 0.9633266457818136},
+		{1, 100, 0.01, 0.079455468904148771},
+		{1, 100, 2, 0.83959486851439447},
+		{1, 100, 15, 0.99980794914598581},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := F{c.D1, c.D2}.CDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestFSF(t *testing.T) {
+	cases := []struct {
+		D1, D2, In, Out float64
+	}{
+		{-1, 1, 1, nan},
+		{2, 2, 0, 1},
+		{2, 2, inf, 0},
+		{3, 7, 0.1, 0.95747067981809675},
+		{3, 7, 1, 0.44707961346848357},
+		{3, 7, 5, 0.03667335421818646},
+		{1, 100, 0.01, 0.92054453109585122},
+		{1, 100, 2, 0.16040513148560548},
+		{1, 100, 15, 0.00019205085401414844},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := F{c.D1, c.D2}.SF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestFQuantile(t *testing.T) {
+	cases := []struct {
+		D1, D2, In, Out float64
+	}{
+		{-1, 1, 0.5, nan},
+		{2, 2, 0, 0},
+		{2, 2, 1, inf},
+		{3, 7, 1e-10, 1.6267130727703683e-07},
+		{3, 7, 0.01, 0.036138008940924789},
+		{3, 7, 0.3, 0.49029710674478821},
+		{3, 7, 0.5, 0.87094425318728474},
+		{3, 7, 0.9, 3.0740719939090013},
+		{3, 7, 0.999999, 151.26567708726876},
+		{1, 100, 1e-10, 1.5786698446087827e-20},
+		{1, 100, 0.01, 0.00015787537543821107},
+		{1, 100, 0.3, 0.14932735689891249},
+		{1, 100, 0.5, 0.45826271463431911},
+		{1, 100, 0.9, 2.7563780175120418},
+		{1, 100, 0.999999, 27.1829552182163},
+		{20, 2, 1e-20, 0.0010101010101010101},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := F{c.D1, c.D2}.Quantile(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
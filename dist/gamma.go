@@ -0,0 +1,85 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/scientificgo/special"
+)
+
+// Gamma is the gamma distribution with shape parameter Shape > 0 and scale parameter Scale > 0,
+// with probability density function
+//
+//	PDF(x) = x**(Shape-1) Exp(-x/Scale) / (Gamma(Shape) Scale**Shape)
+//
+// for x ≥ 0.
+//
+// See http://mathworld.wolfram.com/GammaDistribution.html for more information.
+type Gamma struct {
+	Shape, Scale float64
+}
+
+func (d Gamma) valid() bool {
+	return d.Shape > 0 && d.Scale > 0 && !math.IsInf(d.Shape, 1) && !math.IsInf(d.Scale, 1)
+}
+
+// PDF returns the probability density function of the gamma distribution.
+func (d Gamma) PDF(x float64) float64 {
+	return math.Exp(d.LogPDF(x))
+}
+
+// LogPDF returns the natural logarithm of the probability density function of the gamma distribution.
+func (d Gamma) LogPDF(x float64) float64 {
+	k, theta := d.Shape, d.Scale
+
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x < 0 || math.IsInf(x, 1):
+		return math.Inf(-1)
+	case x == 0:
+		if k < 1 {
+			return math.Inf(1)
+		}
+		if k == 1 {
+			return -math.Log(theta)
+		}
+		return math.Inf(-1)
+	}
+
+	lgk, _ := math.Lgamma(k)
+	y := x / theta
+	return (k-1)*math.Log(y) - y - lgk - math.Log(theta)
+}
+
+// CDF returns the cumulative distribution function of the gamma distribution.
+func (d Gamma) CDF(x float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x <= 0:
+		return 0
+	}
+	return special.GammaRegP(d.Shape, x/d.Scale)
+}
+
+// SF returns the survival function of the gamma distribution.
+func (d Gamma) SF(x float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x <= 0:
+		return 1
+	}
+	return special.GammaRegQ(d.Shape, x/d.Scale)
+}
+
+// Quantile returns the quantile function of the gamma distribution.
+func (d Gamma) Quantile(p float64) float64 {
+	if !d.valid() {
+		return math.NaN()
+	}
+	return d.Scale * special.GammaRegPInv(d.Shape, p)
+}
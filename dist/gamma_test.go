@@ -0,0 +1,164 @@
+package dist_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special/dist"
+)
+
+func TestGammaPDF(t *testing.T) {
+	cases := []struct {
+		Shape, Scale, In, Out float64
+	}{
+		{-1, 1, 1, nan},
+		{1, 0, 1, nan},
+		{2, 1, nan, nan},
+		{2, 1, -1, 0},
+		{0.5, 1, 0, inf},
+		{1, 2, 0, 0.5},
+		{2, 1, 0, 0},
+		{2.5, 1.5, 0.1, 0.0080757466730210104},
+		{2.5, 1.5, 3, 0.19196788093577974},
+		{2.5, 1.5, 20, 3.9544633429325193e-05},
+		{0.3, 2, 1e-05, 858.59787127792606},
+		{0.3, 2, 0.5, 0.34350997700814484},
+		{0.3, 2, 10, 0.0003650224446495174},
+		{200, 0.01, 1.8, 1.0724156141534888},
+		{200, 0.01, 2, 2.8197727685920824},
+		{200, 0.01, 2.5, 0.010485342124273897},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Gamma{c.Shape, c.Scale}.PDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestGammaLogPDF(t *testing.T) {
+	cases := []struct {
+		Shape, Scale, In, Out float64
+	}{
+		{-1, 1, 1, nan},
+		{2, 1, -1, -inf},
+		{2, 1, inf, -inf},
+		{2.5, 1.5, 0.1, -4.8188899469010655},
+		{2.5, 1.5, 3, -1.6504272077411655},
+		{2.5, 1.5, 20, -10.138080563745676},
+		{0.3, 2, 1e-05, 6.7553006764931007},
+		{0.3, 2, 0.5, -1.0685391225940974},
+		{0.3, 2, 10, -7.9155517140818912},
+		{200, 0.01, 1.8, 0.069913687282519468},
+		{200, 0.01, 2, 1.0366563031899534},
+		{200, 0.01, 2.5, -4.5577769852823042},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Gamma{c.Shape, c.Scale}.LogPDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestGammaCDF(t *testing.T) {
+	cases := []struct {
+		Shape, Scale, In, Out float64
+	}{
+		{-1, 1, 1, nan},
+		{2, 1, nan, nan},
+		{2, 1, -1, 0},
+		{2, 1, 0, 0},
+		{2, 1, inf, 1},
+		{2.5, 1.5, 0.1, 0.00032927508792021079},
+		{2.5, 1.5, 3, 0.45058404864721979},
+		{2.5, 1.5, 20, 0.99993376813657675},
+		{0.3, 2, 1e-05, 0.028620039119487},
+		{0.3, 2, 0.5, 0.69554521465665953},
+		{0.3, 2, 10, 0.99934868124928156},
+		{200, 0.01, 1.8, 0.074858034984159591},
+		{200, 0.01, 2, 0.50940341800723621},
+		{200, 0.01, 2.5, 0.99951778724040652},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Gamma{c.Shape, c.Scale}.CDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestGammaSF(t *testing.T) {
+	cases := []struct {
+		Shape, Scale, In, Out float64
+	}{
+		{-1, 1, 1, nan},
+		{2, 1, -1, 1},
+		{2, 1, inf, 0},
+		{2.5, 1.5, 0.1, 0.99967072491207976},
+		{2.5, 1.5, 3, 0.54941595135278021},
+		{2.5, 1.5, 20, 6.6231863423298323e-05},
+		{0.3, 2, 1e-05, 0.97137996088051304},
+		{0.3, 2, 0.5, 0.30445478534334047},
+		{0.3, 2, 10, 0.0006513187507184515},
+		{200, 0.01, 1.8, 0.92514196501584045},
+		{200, 0.01, 2, 0.49059658199276379},
+		{200, 0.01, 2.5, 0.00048221275959343431},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Gamma{c.Shape, c.Scale}.SF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestGammaQuantile(t *testing.T) {
+	cases := []struct {
+		Shape, Scale, In, Out float64
+	}{
+		{-1, 1, 0.5, nan},
+		{2, 1, -0.1, nan},
+		{2, 1, 0, 0},
+		{2, 1, 1, inf},
+		{2.5, 1.5, 1e-10, 0.000242516785968727},
+		{2.5, 1.5, 0.01, 0.41572355754620788},
+		{2.5, 1.5, 0.3, 2.2499310995699298},
+		{2.5, 1.5, 0.5, 3.2635951433216457},
+		{2.5, 1.5, 0.9, 6.9272676748358393},
+		{2.5, 1.5, 0.999999, 26.916140159707815},
+		{0.3, 2, 1e-10, 6.4728804532071035e-34},
+		{0.3, 2, 0.01, 3.0044453104720767e-07},
+		{0.3, 2, 0.3, 0.025453315539897402},
+		{0.3, 2, 0.5, 0.1462622717339038},
+		{0.3, 2, 0.9, 1.7696215467204881},
+		{0.3, 2, 0.999999, 21.969671264217254},
+		{200, 0.01, 1e-10, 1.2274110970189542},
+		{200, 0.01, 0.01, 1.6857765670053309},
+		{200, 0.01, 0.3, 1.9234920303497698},
+		{200, 0.01, 0.5, 1.9966676561246568},
+		{200, 0.01, 0.9, 2.1832449252728869},
+		{200, 0.01, 0.999999, 2.7455761900238387},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Gamma{c.Shape, c.Scale}.Quantile(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
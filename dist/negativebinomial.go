@@ -0,0 +1,99 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/scientificgo/special"
+)
+
+// NegativeBinomial is the negative binomial distribution of the number of failures before
+// the R-th success in independent trials, each with success probability 0 < P ≤ 1, with
+// probability mass function
+//
+//	PDF(k) = Gamma(k+R) / (k! Gamma(R)) P**R (1-P)**k
+//
+// for integers k ≥ 0, where R > 0 need not be an integer.
+//
+// See http://mathworld.wolfram.com/NegativeBinomialDistribution.html for more information.
+type NegativeBinomial struct {
+	R, P float64
+}
+
+func (d NegativeBinomial) valid() bool {
+	return d.R > 0 && !math.IsInf(d.R, 1) && d.P > 0 && d.P <= 1
+}
+
+// PDF returns the probability mass function of the negative binomial distribution.
+func (d NegativeBinomial) PDF(x float64) float64 {
+	return math.Exp(d.LogPDF(x))
+}
+
+// LogPDF returns the natural logarithm of the probability mass function of the negative binomial distribution.
+func (d NegativeBinomial) LogPDF(x float64) float64 {
+	r, p := d.R, d.P
+
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case !isNonNegInt(x) || math.IsInf(x, 1):
+		return math.Inf(-1)
+	case p == 1:
+		if x == 0 {
+			return 0
+		}
+		return math.Inf(-1)
+	}
+
+	lc, _ := special.LgammaRatio([]float64{x + r}, []float64{x + 1, r})
+	return lc + r*math.Log(p) + x*math.Log1p(-p)
+}
+
+// CDF returns the cumulative distribution function of the negative binomial distribution.
+func (d NegativeBinomial) CDF(x float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x < 0:
+		return 0
+	case math.IsInf(x, 1) || d.P == 1:
+		return 1
+	}
+	return special.BetaRegI(d.R, math.Floor(x)+1, d.P)
+}
+
+// SF returns the survival function of the negative binomial distribution.
+func (d NegativeBinomial) SF(x float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x < 0:
+		return 1
+	case math.IsInf(x, 1) || d.P == 1:
+		return 0
+	}
+	return special.BetaRegI(math.Floor(x)+1, d.R, 1-d.P)
+}
+
+// Quantile returns the quantile function of the negative binomial distribution.
+func (d NegativeBinomial) Quantile(p float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(p) || p < 0 || p > 1:
+		return math.NaN()
+	case p == 0 || d.P == 1:
+		return 0
+	case p == 1:
+		return math.Inf(1)
+	}
+
+	// Cornish-Fisher estimate. See 26.2.51, p935, Abramowitz & Stegun.
+	z := normalQuantile(p)
+	q := 1 - d.P
+	m := d.R * q / d.P
+	s := math.Sqrt(m / d.P)
+	k0 := m + s*z + (2-d.P)/d.P*(z*z-1)/6
+	return discreteQuantile(d.CDF, d.SF, p, 1-p, k0, math.Inf(1))
+}
@@ -0,0 +1,147 @@
+package dist_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special/dist"
+)
+
+func TestNegativeBinomialPDF(t *testing.T) {
+	cases := []struct {
+		R, P, In, Out float64
+	}{
+		{-1, 0.5, 1, nan},
+		{2, 0, 1, nan},
+		{2, 0.5, -1, 0},
+		{2, 0.5, 1.5, 0},
+		{2, 1, 0, 1},
+		{2, 1, 3, 0},
+		{5, 0.4, 0, 0.010240000000000003},
+		{5, 0.4, 4, 0.092897280000000013},
+		{5, 0.4, 7.2, 0},
+		{5, 0.4, 30, 0.00010498584679391971},
+		{2.5, 0.9, 0, 0.76843347142091623},
+		{2.5, 0.9, 1, 0.192108367855229},
+		{2.5, 0.9, 5, 9.0140848229570663e-05},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := NegativeBinomial{c.R, c.P}.PDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestNegativeBinomialLogPDF(t *testing.T) {
+	cases := []struct {
+		R, P, In, Out float64
+	}{
+		{2, 1.5, 1, nan},
+		{2, 0.5, 1.5, -inf},
+		{5, 0.4, 0, -4.5814536593707746},
+		{5, 0.4, 4, -2.3762609123853791},
+		{5, 0.4, 7.2, -inf},
+		{5, 0.4, 30, -9.1616850093309274},
+		{2.5, 0.9, 0, -0.26340128914456568},
+		{2.5, 0.9, 1, -1.6496956502644566},
+		{2.5, 0.9, 5, -9.3141371306110265},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := NegativeBinomial{c.R, c.P}.LogPDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestNegativeBinomialCDF(t *testing.T) {
+	cases := []struct {
+		R, P, In, Out float64
+	}{
+		{-1, 0.5, 1, nan},
+		{2, 0.5, -1, 0},
+		{2, 0.5, inf, 1},
+		{2, 1, 0, 1},
+		{5, 0.4, 0, 0.010240000000000003},
+		{5, 0.4, 4, 0.26656768000000003},
+		{5, 0.4, 7.2, 0.5618217779200001},
+		{5, 0.4, 30, 0.99978389985438287},
+		{2.5, 0.9, 0, 0.76843347142091623},
+		{2.5, 0.9, 1, 0.96054183927614523},
+		{2.5, 0.9, 5, 0.99998718029546707},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := NegativeBinomial{c.R, c.P}.CDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestNegativeBinomialSF(t *testing.T) {
+	cases := []struct {
+		R, P, In, Out float64
+	}{
+		{-1, 0.5, 1, nan},
+		{2, 0.5, -1, 1},
+		{2, 1, 0, 0},
+		{5, 0.4, 0, 0.98975999999999997},
+		{5, 0.4, 4, 0.73343231999999992},
+		{5, 0.4, 7.2, 0.43817822207999996},
+		{5, 0.4, 30, 0.00021610014561711537},
+		{2.5, 0.9, 0, 0.23156652857908377},
+		{2.5, 0.9, 1, 0.03945816072385476},
+		{2.5, 0.9, 5, 1.2819704532894614e-05},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := NegativeBinomial{c.R, c.P}.SF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestNegativeBinomialQuantile(t *testing.T) {
+	cases := []struct {
+		R, P, In, Out float64
+	}{
+		{-1, 0.5, 0.5, nan},
+		{2, 0.5, 0, 0},
+		{2, 0.5, 1, inf},
+		{2, 1, 0.5, 0},
+		{5, 0.4, 1e-10, 0},
+		{5, 0.4, 0.01, 0},
+		{5, 0.4, 0.3, 5},
+		{5, 0.4, 0.5, 7},
+		{5, 0.4, 0.9, 13},
+		{5, 0.4, 0.999999, 43},
+		{2.5, 0.9, 1e-10, 0},
+		{2.5, 0.9, 0.01, 0},
+		{2.5, 0.9, 0.3, 0},
+		{2.5, 0.9, 0.5, 0},
+		{2.5, 0.9, 0.9, 1},
+		{2.5, 0.9, 0.999999, 7},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := NegativeBinomial{c.R, c.P}.Quantile(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
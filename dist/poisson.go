@@ -0,0 +1,88 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/scientificgo/special"
+)
+
+// Poisson is the Poisson distribution with mean Lambda > 0, with probability mass function
+//
+//	PDF(k) = Lambda**k Exp(-Lambda) / k!
+//
+// for integers k ≥ 0.
+//
+// See http://mathworld.wolfram.com/PoissonDistribution.html for more information.
+type Poisson struct {
+	Lambda float64
+}
+
+func (d Poisson) valid() bool {
+	return d.Lambda > 0 && !math.IsInf(d.Lambda, 1)
+}
+
+// PDF returns the probability mass function of the Poisson distribution.
+func (d Poisson) PDF(x float64) float64 {
+	return math.Exp(d.LogPDF(x))
+}
+
+// LogPDF returns the natural logarithm of the probability mass function of the Poisson distribution.
+func (d Poisson) LogPDF(x float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case !isNonNegInt(x) || math.IsInf(x, 1):
+		return math.Inf(-1)
+	}
+
+	lgx1, _ := math.Lgamma(x + 1)
+	return x*math.Log(d.Lambda) - d.Lambda - lgx1
+}
+
+// CDF returns the cumulative distribution function of the Poisson distribution.
+func (d Poisson) CDF(x float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x < 0:
+		return 0
+	case math.IsInf(x, 1):
+		return 1
+	}
+	return special.GammaRegQ(math.Floor(x)+1, d.Lambda)
+}
+
+// SF returns the survival function of the Poisson distribution.
+func (d Poisson) SF(x float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x < 0:
+		return 1
+	case math.IsInf(x, 1):
+		return 0
+	}
+	return special.GammaRegP(math.Floor(x)+1, d.Lambda)
+}
+
+// Quantile returns the quantile function of the Poisson distribution.
+func (d Poisson) Quantile(p float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(p) || p < 0 || p > 1:
+		return math.NaN()
+	case p == 0:
+		return 0
+	case p == 1:
+		return math.Inf(1)
+	}
+
+	// Cornish-Fisher estimate. See 26.2.51, p935, Abramowitz & Stegun.
+	z := normalQuantile(p)
+	s := math.Sqrt(d.Lambda)
+	k0 := d.Lambda + s*z + (z*z-1)/6
+	return discreteQuantile(d.CDF, d.SF, p, 1-p, k0, math.Inf(1))
+}
@@ -0,0 +1,145 @@
+package dist_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special/dist"
+)
+
+func TestPoissonPDF(t *testing.T) {
+	cases := []struct {
+		Lambda, In, Out float64
+	}{
+		{-1, 1, nan},
+		{2, nan, nan},
+		{2, -1, 0},
+		{2, 1.5, 0},
+		{2, 0, 0.1353352832366127},
+		{3.5, 0, 0.030197383422318501},
+		{3.5, 2, 0.18495897346170082},
+		{3.5, 3.7, 0},
+		{3.5, 10, 0.0022955498270153577},
+		{1000, 900, 7.5169543521259519e-05},
+		{1000, 1000, 0.012614611348721499},
+		{1000, 1100, 9.4989442422995071e-05},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Poisson{c.Lambda}.PDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestPoissonLogPDF(t *testing.T) {
+	cases := []struct {
+		Lambda, In, Out float64
+	}{
+		{0, 1, nan},
+		{2, 1.5, -inf},
+		{3.5, 0, -3.5},
+		{3.5, 2, -1.6876212435692093},
+		{3.5, 3.7, -inf},
+		{3.5, 10, -6.0767828881218353},
+		{1000, 900, -9.4957644154119389},
+		{1000, 1000, -4.3728995060262967},
+		{1000, 1100, -9.2617448049289202},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Poisson{c.Lambda}.LogPDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestPoissonCDF(t *testing.T) {
+	cases := []struct {
+		Lambda, In, Out float64
+	}{
+		{-1, 1, nan},
+		{2, -0.5, 0},
+		{2, inf, 1},
+		{3.5, 0, 0.030197383422318501},
+		{3.5, 2, 0.32084719886213409},
+		{3.5, 3.7, 0.53663266790078501},
+		{3.5, 10, 0.998980605562383},
+		{1000, 900, 0.00069776732779630677},
+		{1000, 1000, 0.50840936716850604},
+		{1000, 1100, 0.99913235903655639},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Poisson{c.Lambda}.CDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestPoissonSF(t *testing.T) {
+	cases := []struct {
+		Lambda, In, Out float64
+	}{
+		{-1, 1, nan},
+		{2, -0.5, 1},
+		{2, inf, 0},
+		{3.5, 0, 0.96980261657768152},
+		{3.5, 2, 0.67915280113786591},
+		{3.5, 3.7, 0.46336733209921499},
+		{3.5, 10, 0.001019394437617005},
+		{1000, 900, 0.99930223267220364},
+		{1000, 1000, 0.49159063283149401},
+		{1000, 1100, 0.00086764096344356205},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Poisson{c.Lambda}.SF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestPoissonQuantile(t *testing.T) {
+	cases := []struct {
+		Lambda, In, Out float64
+	}{
+		{-1, 0.5, nan},
+		{2, 1.1, nan},
+		{2, 0, 0},
+		{2, 1, inf},
+		{3.5, 1e-10, 0},
+		{3.5, 0.01, 0},
+		{3.5, 0.3, 2},
+		{3.5, 0.5, 3},
+		{3.5, 0.9, 6},
+		{3.5, 0.999999, 15},
+		{1000, 1e-10, 806},
+		{1000, 0.01, 927},
+		{1000, 0.3, 983},
+		{1000, 0.5, 1000},
+		{1000, 0.9, 1041},
+		{1000, 0.999999, 1154},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Poisson{c.Lambda}.Quantile(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/scientificgo/special"
+)
+
+// StudentT is Student's t-distribution with Nu > 0 degrees of freedom, with probability density function
+//
+//	PDF(x) = (1 + x**2/Nu)**(-(Nu+1)/2) / (Sqrt(Nu) Beta(Nu/2, 1/2))
+//
+// for all real x.
+//
+// See http://mathworld.wolfram.com/Studentst-Distribution.html for more information.
+type StudentT struct {
+	Nu float64
+}
+
+func (d StudentT) valid() bool {
+	return d.Nu > 0 && !math.IsInf(d.Nu, 1)
+}
+
+// PDF returns the probability density function of Student's t-distribution.
+func (d StudentT) PDF(x float64) float64 {
+	return math.Exp(d.LogPDF(x))
+}
+
+// LogPDF returns the natural logarithm of the probability density function of Student's t-distribution.
+func (d StudentT) LogPDF(x float64) float64 {
+	nu := d.Nu
+
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case math.IsInf(x, 0):
+		return math.Inf(-1)
+	}
+
+	// Log(1 + x**2/Nu), avoiding overflow of x**2.
+	var l float64
+	if ax := math.Abs(x); ax < 1e150 {
+		l = math.Log1p(x * x / nu)
+	} else {
+		l = 2*math.Log(ax) - math.Log(nu)
+	}
+
+	lb, _ := special.LgammaRatio([]float64{nu / 2, 0.5}, []float64{(nu + 1) / 2})
+	return -(nu+1)/2*l - math.Log(nu)/2 - lb
+}
+
+// CDF returns the cumulative distribution function of Student's t-distribution.
+func (d StudentT) CDF(x float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(x):
+		return math.NaN()
+	case x == 0:
+		return 0.5
+	}
+
+	// The probability P(|X| > |x|) / 2.
+	tail := special.BetaRegI(d.Nu/2, 0.5, d.Nu/(d.Nu+x*x)) / 2
+	if x < 0 {
+		return tail
+	}
+	return 1 - tail
+}
+
+// SF returns the survival function of Student's t-distribution.
+func (d StudentT) SF(x float64) float64 {
+	return d.CDF(-x)
+}
+
+// Quantile returns the quantile function of Student's t-distribution.
+func (d StudentT) Quantile(p float64) float64 {
+	// Special cases.
+	switch {
+	case !d.valid() || math.IsNaN(p) || p < 0 || p > 1:
+		return math.NaN()
+	case p == 0:
+		return math.Inf(-1)
+	case p == 1:
+		return math.Inf(1)
+	case p == 0.5:
+		return 0
+	case p > 0.5:
+		return -d.Quantile(1 - p)
+	}
+
+	// Solve BetaRegI(Nu/2, 1/2, z) = 2p, where z = Nu/(Nu+x**2) and w = 1-z.
+	z := special.BetaRegIInv(d.Nu/2, 0.5, 2*p)
+	w := 1 - z
+	if z > 0.5 {
+		w = special.BetaRegIInvC(0.5, d.Nu/2, 2*p)
+	}
+	return -math.Sqrt(d.Nu * w / z)
+}
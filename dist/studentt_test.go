@@ -0,0 +1,193 @@
+package dist_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special/dist"
+)
+
+func TestStudentTPDF(t *testing.T) {
+	cases := []struct {
+		Nu, In, Out float64
+	}{
+		{-1, 1, nan},
+		{2, nan, nan},
+		{2, inf, 0},
+		{1, 0, 0.3183098861837907},
+		{1, -20, 0.00079379023985982715},
+		{1, -1, 0.15915494309189535},
+		{1, 0.5, 0.25464790894703254},
+		{1, 3, 0.031830988618379068},
+		{4.5, -3, 0.018402946650727739},
+		{4.5, 0.1, 0.37524917000342062},
+		{4.5, 2, 0.065675469291599842},
+		{4.5, 50, 1.0637078919681977e-08},
+		{300, -2, 0.054302854146502301},
+		{300, 0.5, 0.35164388573792571},
+		{300, 4, 0.00016009989815958582},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := StudentT{c.Nu}.PDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestStudentTLogPDF(t *testing.T) {
+	cases := []struct {
+		Nu, In, Out float64
+	}{
+		{0, 1, nan},
+		{2, -inf, -inf},
+		{1, -20, -7.1386913131559693},
+		{1, -1, -1.8378770664093456},
+		{1, 0.5, -1.3678734371636099},
+		{1, 3, -3.4473149788434458},
+		{4.5, -3, -3.9952444831275984},
+		{4.5, 0.1, -0.98016502032065711},
+		{4.5, 2, -2.7230297977702875},
+		{4.5, 50, -18.358919928349138},
+		{300, -2, -2.913178490872923},
+		{300, 0.5, -1.0451363037305421},
+		{300, 4, -8.7397125740668304},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := StudentT{c.Nu}.LogPDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestStudentTCDF(t *testing.T) {
+	cases := []struct {
+		Nu, In, Out float64
+	}{
+		{-1, 1, nan},
+		{2, 0, 0.5},
+		{2, -inf, 0},
+		{2, inf, 1},
+		{1, -20, 0.015902251256176374},
+		{1, -1, 0.25},
+		{1, 0.5, 0.64758361765043326},
+		{1, 3, 0.89758361765043326},
+		{4.5, -3, 0.017190433944379812},
+		{4.5, 0.1, 0.53767796618465724},
+		{4.5, 2, 0.94587104640943753},
+		{4.5, 50, 0.99999988163026021},
+		{300, -2, 0.023200760491574546},
+		{300, 0.5, 0.69127918872288741},
+		{300, 4, 0.99996007997758141},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := StudentT{c.Nu}.CDF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestStudentTSF(t *testing.T) {
+	cases := []struct {
+		Nu, In, Out float64
+	}{
+		{-1, 1, nan},
+		{2, 0, 0.5},
+		{2, -inf, 1},
+		{1, -20, 0.98409774874382361},
+		{1, -1, 0.75},
+		{1, 0.5, 0.35241638234956674},
+		{1, 3, 0.10241638234956672},
+		{4.5, -3, 0.9828095660556202},
+		{4.5, 0.1, 0.46232203381534276},
+		{4.5, 2, 0.054128953590562516},
+		{4.5, 50, 1.1836973977841895e-07},
+		{300, -2, 0.97679923950842551},
+		{300, 0.5, 0.30872081127711259},
+		{300, 4, 3.9920022418597324e-05},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := StudentT{c.Nu}.SF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestStudentTQuantile(t *testing.T) {
+	cases := []struct {
+		Nu, In, Out float64
+	}{
+		{-1, 0.5, nan},
+		{2, 0, -inf},
+		{2, 1, inf},
+		{2, 0.5, 0},
+		{1, 1e-10, -3183098861.8379068},
+		{1, 0.01, -31.820515953773956},
+		{1, 0.3, -0.7265425280053609},
+		{1, 0.5, 0},
+		{1, 0.9, 3.077683537175254},
+		{1, 0.999999, 318309.88617359026},
+		{4.5, 1e-10, -241.11742919193401},
+		{4.5, 0.01, -3.5270508906260924},
+		{4.5, 0.3, -0.56350580393937788},
+		{4.5, 0.5, 0},
+		{4.5, 0.9, 1.5008853175085577},
+		{4.5, 0.999999, 31.081356582949841},
+		{300, 1e-10, -6.5878115764844001},
+		{300, 0.01, -2.3388419237869926},
+		{300, 0.3, -0.52495815809753299},
+		{300, 0.5, 0},
+		{300, 0.9, 1.2843798675790132},
+		{300, 0.999999, 4.8485143011038714},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := StudentT{c.Nu}.Quantile(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestStudentTQuantileRoundTrip(t *testing.T) {
+	cases := []struct {
+		Nu, In float64
+	}{
+		{0.02, 0.01},
+		{0.02, 0.3},
+		{0.02, 0.7},
+		{0.1, 1e-10},
+		{0.1, 0.45},
+		{1, 1e-10},
+		{300, 0.01},
+		{200, 1e-20},
+		{30, 1e-100},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			d := StudentT{c.Nu}
+			res := d.CDF(d.Quantile(c.In))
+			ok := equalFloat64(res, c.In)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.In)
+			}
+		})
+	}
+}
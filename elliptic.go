@@ -0,0 +1,167 @@
+package special
+
+import "math"
+
+// The implementation of elliptic_cel follows the algorithm of:
+// R. Bulirsch. Numerical calculation of elliptic integrals and elliptic functions. III.
+// Numerische Mathematik 13, 305–315 (1969).
+
+// EllipticK returns the complete elliptic integral of the first kind, defined by
+//
+//	              π/2
+//	EllipticK(m) = ∫ dθ / Sqrt(1 - m Sin(θ)**2) = π / [2 AGM(1, Sqrt(1-m))]
+//	              θ=0
+//
+// where m = k**2 is the parameter, k is the elliptic modulus and AGM is the arithmetic-geometric
+// mean. EllipticK is real for m ≤ 1.
+//
+// See http://mathworld.wolfram.com/CompleteEllipticIntegraloftheFirstKind.html for more information.
+func EllipticK(m float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(m) || m > 1:
+		return math.NaN()
+	case m == 1:
+		return math.Inf(1)
+	case math.IsInf(m, -1):
+		return 0
+	}
+	return math.Pi / (2 * agm(1, math.Sqrt(1-m)))
+}
+
+// EllipticE returns the complete elliptic integral of the second kind, defined by
+//
+//	              π/2
+//	EllipticE(m) = ∫ dθ Sqrt(1 - m Sin(θ)**2)
+//	              θ=0
+//
+// where m = k**2 is the parameter and k is the elliptic modulus. EllipticE is real for m ≤ 1.
+//
+// See http://mathworld.wolfram.com/CompleteEllipticIntegraloftheSecondKind.html for more information.
+func EllipticE(m float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(m) || m > 1:
+		return math.NaN()
+	case m == 1:
+		return 1
+	case math.IsInf(m, -1):
+		return math.Inf(1)
+	case m < 0:
+		// Imaginary modulus transformation, EllipticE(m) = Sqrt(1-m) EllipticE(m/(m-1)).
+		// See 17.4.18, p593, Abramowitz & Stegun.
+		return math.Sqrt(1-m) * EllipticE(m/(m-1))
+	}
+
+	// Using the AGM sequences a[n], b[n] and c[n], with a[0] = 1, b[0] = Sqrt(1-m),
+	// c[0] = Sqrt(m) and c[n+1] = (a[n] - b[n]) / 2,
+	//
+	//	                              ∞
+	//	EllipticE(m) = EllipticK(m) [1 - ∑ 2**(n-1) c[n]**2]
+	//	                             n=0
+	//
+	// See 17.6.3-17.6.4, p599, Abramowitz & Stegun.
+	const (
+		maxiter = 100
+		tol     = 1e-10 // Convergence is quadratic, so c[n+1] is below the machine epsilon.
+	)
+
+	a, b := 1.0, math.Sqrt(1-m)
+	sum := m / 2
+	p := 0.5
+	for i := 0; i < maxiter; i++ {
+		c := (a - b) / 2
+		a, b = (a+b)/2, math.Sqrt(a*b)
+		p *= 2
+		sum += p * c * c
+		if math.Abs(c) < tol*a {
+			break
+		}
+	}
+	return math.Pi / (2 * a) * (1 - sum)
+}
+
+// EllipticPi returns the complete elliptic integral of the third kind, defined by
+//
+//	                  π/2
+//	EllipticPi(n, m) = ∫ dθ / [(1 - n Sin(θ)**2) Sqrt(1 - m Sin(θ)**2)]
+//	                  θ=0
+//
+// where n is the characteristic, m = k**2 is the parameter and k is the elliptic modulus.
+// EllipticPi is real for m ≤ 1 and n < 1; for n > 1 the integral is singular and EllipticPi
+// returns its Cauchy principal value.
+//
+// See http://mathworld.wolfram.com/CompleteEllipticIntegraloftheThirdKind.html for more information.
+func EllipticPi(n, m float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(n) || math.IsNaN(m) || m > 1:
+		return math.NaN()
+	case m == 1 || n == 1:
+		return math.Inf(1)
+	case math.IsInf(n, 0) || math.IsInf(m, -1):
+		return 0
+	case n == 0:
+		return EllipticK(m)
+	}
+	return elliptic_cel(math.Sqrt(1-m), 1-n, 1, 1)
+}
+
+// agm returns the arithmetic-geometric mean of a > 0 and b > 0.
+func agm(a, b float64) float64 {
+	const (
+		maxiter = 100
+		tol     = 1e-10
+	)
+	for i := 0; i < maxiter && math.Abs(a-b) >= tol*a; i++ {
+		a, b = (a+b)/2, math.Sqrt(a*b)
+	}
+	return (a + b) / 2
+}
+
+// elliptic_cel returns Bulirsch's general complete elliptic integral, defined by
+//
+//	                           π/2
+//	elliptic_cel(kc, p, a, b) = ∫ dθ [a C**2 + b S**2] / [(C**2 + p S**2) Sqrt(C**2 + kc**2 S**2)]
+//	                           θ=0
+//
+// where C = Cos(θ) and S = Sin(θ), for kc ≠ 0 and p ≠ 0, taking the Cauchy principal value for p < 0.
+func elliptic_cel(kc, p, a, b float64) float64 {
+	const (
+		maxiter = 100
+		tol     = 1.5e-8 // Sqrt of the machine epsilon, since convergence is quadratic.
+	)
+
+	kc = math.Abs(kc)
+	e := kc
+	em := 1.0
+	if p > 0 {
+		p = math.Sqrt(p)
+		b /= p
+	} else {
+		f := kc * kc
+		q := 1 - f
+		g := 1 - p
+		f -= p
+		q *= b - a*p
+		p = math.Sqrt(f / g)
+		a = (a - b) / g
+		b = -q/(g*g*p) + a*p
+	}
+	for i := 0; i < maxiter; i++ {
+		f := a
+		a += b / p
+		g := e / p
+		b += f * g
+		b += b
+		p += g
+		g = em
+		em += kc
+		if math.Abs(g-kc) <= g*tol {
+			break
+		}
+		kc = 2 * math.Sqrt(e)
+		e = kc * em
+	}
+	return math.Pi / 2 * (b + a*em) / (em * (em + p))
+}
@@ -0,0 +1,115 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestEllipticK(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{1.5, nan},
+		{1, inf},
+		{-inf, 0},
+		{-1e300, 3.4677405831022676e-148},
+		{-1e10, 0.00012899219825792638},
+		{-100, 0.36821924860914101},
+		{-1, 1.3110287771460598},
+		{-0.01, 1.5668912730681963},
+		{0, 1.5707963267948966},
+		{1e-10, 1.5707963268341665},
+		{0.1, 1.6124413487202194},
+		{0.5, 1.8540746773013719},
+		{0.9, 2.5780921133481733},
+		{0.99, 3.6956373629898742},
+		{0.999999, 8.2940514636010629},
+		{0.9999999999, 12.899219785017415},
+		{0.99999999999999989, 19.754694645958441},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := EllipticK(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestEllipticE(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{1.5, nan},
+		{1, 1},
+		{-inf, inf},
+		{-1e300, 9.9999999999999998e+149},
+		{-1e10, 100000.0000669961},
+		{-100, 10.209260919814572},
+		{-1, 1.9100988945138559},
+		{-0.01, 1.5747159850169885},
+		{0, 1.5707963267948966},
+		{1e-10, 1.5707963267556266},
+		{0.1, 1.5307576368977631},
+		{0.5, 1.3506438810476755},
+		{0.9, 1.1047747327040733},
+		{0.99, 1.015993545025224},
+		{0.999999, 1.0000038970261722},
+		{0.9999999999, 1.000000000619961},
+		{0.99999999999999989, 1.0000000000000011},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := EllipticE(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestEllipticPi(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 0.5, nan},
+		{0.5, nan, nan},
+		{0.5, 1.5, nan},
+		{0.5, 1, inf},
+		{1, 0.5, inf},
+		{-inf, 0.5, 0},
+		{inf, 0.5, 0},
+		{0.5, -inf, 0},
+		{0, 0.5, 1.8540746773013719},
+		{0.3, 0.5, 2.2503768219439468},
+		{-1, 0.5, 1.2731273667496825},
+		{0.9, 0.1, 5.1694734021576965},
+		{0.5, -3, 1.4400343186575506},
+		{-100, 0.2, 0.15784731712143857},
+		{0.99, 0.99, 101.5993545025223},
+		{0.5, 0.5, 2.701287762095351},
+		{2, 0.5, -0.31354468346518405},
+		{1.5, -1, 0.27292654182484155},
+		{10, 0.9, -0.15920202073190723},
+		{1e-08, 0.3, 1.7138894571296706},
+		{0.999999, 0.5, 2220.5953652198873},
+		{-1e6, 0.5, 0.0015712985793004195},
+		{0.5, 0.999999, 15.341658994533224},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := EllipticPi(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package special
+
+import "math"
+
+// EllipticF returns the incomplete elliptic integral of the first kind, defined by
+//
+//	                   phi
+//	EllipticF(phi, m) = ∫ dθ / Sqrt(1 - m Sin(θ)**2) = Sin(phi) CarlsonRF(Cos(phi)**2, 1 - m Sin(phi)**2, 1)
+//	                   θ=0
+//
+// where phi is the amplitude, m = k**2 is the parameter and k is the elliptic modulus. For |phi| > π/2,
+// EllipticF(phi + jπ, m) = EllipticF(phi, m) + 2j EllipticK(m). EllipticF is real for m Sin(phi)**2 ≤ 1.
+//
+// See http://mathworld.wolfram.com/EllipticIntegraloftheFirstKind.html for more information.
+func EllipticF(phi, m float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(phi) || math.IsNaN(m):
+		return math.NaN()
+	case phi == 0 || m == 0:
+		return phi
+	case math.IsInf(m, -1):
+		return 0
+	case math.IsInf(phi, 0):
+		if m > 1 {
+			return math.NaN()
+		}
+		return phi
+	}
+
+	j, phi := elliptic_reduce(phi)
+	s, c := math.Sin(phi), math.Cos(phi)
+	d := c*c + (1-m)*s*s
+	if d < 0 {
+		return math.NaN()
+	}
+
+	f := s * CarlsonRF(c*c, d, 1)
+	if j != 0 {
+		f += 2 * j * EllipticK(m)
+	}
+	return f
+}
+
+// EllipticEInc returns the incomplete elliptic integral of the second kind, defined by
+//
+//	                      phi
+//	EllipticEInc(phi, m) = ∫ dθ Sqrt(1 - m Sin(θ)**2)
+//	                      θ=0
+//
+//	                   = Sin(phi) CarlsonRF(Cos(phi)**2, 1 - m Sin(phi)**2, 1) -
+//	                     (m/3) Sin(phi)**3 CarlsonRD(Cos(phi)**2, 1 - m Sin(phi)**2, 1)
+//
+// where phi is the amplitude, m = k**2 is the parameter and k is the elliptic modulus. For |phi| > π/2,
+// EllipticEInc(phi + jπ, m) = EllipticEInc(phi, m) + 2j EllipticE(m). EllipticEInc is real for
+// m Sin(phi)**2 ≤ 1.
+//
+// See http://mathworld.wolfram.com/EllipticIntegraloftheSecondKind.html for more information.
+func EllipticEInc(phi, m float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(phi) || math.IsNaN(m):
+		return math.NaN()
+	case phi == 0 || m == 0:
+		return phi
+	case math.IsInf(phi, 0) || math.IsInf(m, -1):
+		if m > 1 {
+			return math.NaN()
+		}
+		return math.Copysign(math.Inf(1), phi)
+	}
+
+	j, phi := elliptic_reduce(phi)
+	s, c := math.Sin(phi), math.Cos(phi)
+	d := c*c + (1-m)*s*s
+	if d < 0 {
+		return math.NaN()
+	}
+
+	var e float64
+	if m == 1 {
+		e = s
+	} else {
+		c2 := c * c
+		e = s*CarlsonRF(c2, d, 1) - m/3*s*s*s*CarlsonRD(c2, d, 1)
+	}
+	if j != 0 {
+		e += 2 * j * EllipticE(m)
+	}
+	return e
+}
+
+// EllipticPiInc returns the incomplete elliptic integral of the third kind, defined by
+//
+//	                          phi
+//	EllipticPiInc(n, phi, m) = ∫ dθ / [(1 - n Sin(θ)**2) Sqrt(1 - m Sin(θ)**2)]
+//	                          θ=0
+//
+//	                       = Sin(phi) CarlsonRF(Cos(phi)**2, 1 - m Sin(phi)**2, 1) +
+//	                         (n/3) Sin(phi)**3 CarlsonRJ(Cos(phi)**2, 1 - m Sin(phi)**2, 1, 1 - n Sin(phi)**2)
+//
+// where n is the characteristic, phi is the amplitude, m = k**2 is the parameter and k is the elliptic
+// modulus. For |phi| > π/2, EllipticPiInc(n, phi + jπ, m) = EllipticPiInc(n, phi, m) + 2j EllipticPi(n, m).
+// EllipticPiInc is real for m Sin(phi)**2 ≤ 1; for n Sin(phi)**2 > 1 the integral is singular and
+// EllipticPiInc returns its Cauchy principal value.
+//
+// See http://mathworld.wolfram.com/EllipticIntegraloftheThirdKind.html for more information.
+func EllipticPiInc(n, phi, m float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(n) || math.IsNaN(phi) || math.IsNaN(m):
+		return math.NaN()
+	case math.IsInf(phi, 0):
+		if m > 1 || n > 1 {
+			return math.NaN()
+		}
+		return phi
+	case phi == 0:
+		return phi
+	case n == 0:
+		return EllipticF(phi, m)
+	case math.IsInf(n, 0) || math.IsInf(m, -1):
+		return 0
+	}
+
+	j, phi := elliptic_reduce(phi)
+	s, c := math.Sin(phi), math.Cos(phi)
+	s2 := s * s
+	d := c*c + (1-m)*s2
+	if d < 0 {
+		return math.NaN()
+	}
+
+	c2 := c * c
+	p := s*CarlsonRF(c2, d, 1) + n/3*s*s2*CarlsonRJ(c2, d, 1, 1-n*s2)
+	if j != 0 {
+		p += 2 * j * EllipticPi(n, m)
+	}
+	return p
+}
+
+// elliptic_reduce returns j and phi - jπ, where j is the integer nearest to phi/π,
+// such that |phi - jπ| ≤ π/2.
+func elliptic_reduce(phi float64) (float64, float64) {
+	if math.Abs(phi) <= math.Pi/2 {
+		return 0, phi
+	}
+	j := math.Round(phi / math.Pi)
+	return j, phi - j*math.Pi
+}
@@ -0,0 +1,110 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestEllipticF(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 0.5, nan},
+		{0.5, nan, nan},
+		{0, 0.5, 0},
+		{0.5, 0, 0.5},
+		{1, 2, nan},
+		{inf, 0.5, inf},
+		{-inf, 0.5, -inf},
+		{inf, 2, nan},
+		{0.5, -inf, 0},
+		{1.5707963267948966, 0.5, 1.8540746773013719},
+		{0.5, 0.3, 0.50614021196235526},
+		{1.2, 0.9, 1.5648981345066715},
+		{-1, 0.5, -1.0832167728451687},
+		{1.5707963267948966, 1, 38.025003373828866},
+		{1, 1, 1.2261911708835171},
+		{0.3, 2, 0.30962057562563439},
+		{5, 0.7, 6.7358483532488318},
+		{-10, -3, -6.9797965893463063},
+		{2, -100, 0.41228682267746297},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := EllipticF(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestEllipticEInc(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 0.5, nan},
+		{0.5, nan, nan},
+		{0, 0.5, 0},
+		{0.5, 0, 0.5},
+		{1, 2, nan},
+		{inf, 0.5, inf},
+		{-inf, 0.5, -inf},
+		{0.5, -inf, inf},
+		{1.5707963267948966, 0.5, 1.3506438810476755},
+		{0.5, 1, 0.479425538604203},
+		{0.5, 0.3, 0.49399114472896843},
+		{1.2, 0.9, 0.96703766028867499},
+		{-1, 0.5, -0.92732988362444002},
+		{1.5707963267948966, 0.999, 1.0021707908344453},
+		{0.3, 2, 0.29091187342645991},
+		{5, 0.7, 3.8873948219108216},
+		{-10, -3, -15.187605310927667},
+		{2, -100, 14.392821590556949},
+		{4, 1, 2.7568024953079284},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := EllipticEInc(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestEllipticPiInc(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, Out float64
+	}{
+		{nan, 0.5, 0.5, nan},
+		{0.5, nan, 0.5, nan},
+		{0.5, 0.5, nan, nan},
+		{0.5, 0, 0.5, 0},
+		{0.5, 1, 2, nan},
+		{0, 0.5, 0.3, 0.50614021196235526},
+		{inf, 0.5, 0.5, 0},
+		{0.5, 1.5707963267948966, 0.5, 2.701287762095351},
+		{0.3, 0.5, 0.3, 0.51882846748781808},
+		{-2, 1.2, 0.9, 0.9463344603286109},
+		{0.9, -1, 0.5, -1.6020549483274775},
+		{2, 1, 0.5, 0.70458374676879831},
+		{5, 1.2, 0.3, 0.060230127201212943},
+		{0.5, 5, 0.7, 10.262720653322862},
+		{-1, -10, -3, -5.3211783023409254},
+		{1.5, 0.3, 2, 0.3248908384980036},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := EllipticPiInc(c.In1, c.In2, c.In3)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
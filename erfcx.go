@@ -0,0 +1,51 @@
+package special
+
+import "math"
+
+// Erfcx returns the scaled complementary error function, defined by
+//
+//	Erfcx(x) = Exp(x**2) Erfc(x)
+//
+// which remains finite as x -> +∞, where Erfc underflows.
+//
+// See http://mathworld.wolfram.com/Erfc.html for more information.
+func Erfcx(x float64) float64 {
+	const xlarge = 25
+
+	// Special cases.
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case math.IsInf(x, 1):
+		return 0
+	case math.IsInf(x, -1):
+		return math.Inf(1)
+	case x < 0:
+		return 2*expx2(x) - Erfcx(-x)
+	case x < xlarge:
+		return expx2(x) * math.Erfc(x)
+	}
+	return erfcx_asymptotic(x)
+}
+
+// erfcx_asymptotic returns Erfcx(x) using the asymptotic expansion for large x, i.e.
+//
+//	                           ∞
+//	Erfcx(x) ~ 1 / (x Sqrt(π)) ∑ (-1)**k (2k-1)!! / (2x**2)**k
+//	                          k=0
+//
+// See 7.1.23, p298, Abramowitz & Stegun.
+func erfcx_asymptotic(x float64) float64 {
+	const (
+		maxiter = 100
+		tol     = 1e-17
+	)
+
+	y := 1 / (2 * x * x)
+	sum, t := 1.0, 1.0
+	for k := 1; k < maxiter && math.Abs(t) > tol; k++ {
+		t *= -float64(2*k-1) * y
+		sum += t
+	}
+	return sum / (x * math.SqrtPi)
+}
@@ -0,0 +1,42 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestErfcx(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{inf, 0},
+		{-inf, inf},
+		{0, 1},
+		{-30, inf},
+		{-5, 144009798674.66104},
+		{-1, 5.0089800807622833},
+		{-0.1, 1.1236433541992095},
+		{1e-08, 0.99999998871620843},
+		{0.5, 0.6156903441929259},
+		{1, 0.427583576155807},
+		{2, 0.25539567631050575},
+		{5, 0.11070463773306863},
+		{10, 0.056140992743822588},
+		{24.9, 0.022639987776049506},
+		{25.1, 0.022459875817581389},
+		{50, 0.011281536265323773},
+		{1000, 0.00056418930145338763},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Erfcx(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
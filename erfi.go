@@ -0,0 +1,57 @@
+package special
+
+import "math"
+
+// Erfi returns the imaginary error function, defined by
+//
+//	                                     x
+//	Erfi(x) = -i Erf(ix) = (2 / Sqrt(π)) ∫ dt Exp(t**2)
+//	                                    t=0
+//
+// See http://mathworld.wolfram.com/Erfi.html for more information.
+func Erfi(x float64) float64 {
+	const (
+		xsmall = 2
+		xover  = 709
+	)
+
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, 0):
+		return x
+	case math.Abs(x) <= xsmall:
+		return erfi_series(x)
+	}
+
+	d := 2 / math.SqrtPi * Dawson(x)
+	if x*x < xover {
+		return expx2(x) * d
+	}
+	return math.Copysign(math.Exp(x*x+math.Log(math.Abs(d))), x)
+}
+
+// erfi_series returns Erfi(x) using the power series
+//
+//	                        ∞
+//	Erfi(x) = (2 / Sqrt(π)) ∑ x**(2k+1) / [k! (2k+1)]
+//	                       k=0
+//
+// which has only positive terms.
+func erfi_series(x float64) float64 {
+	const (
+		maxiter = 100
+		tol     = 1e-17
+	)
+
+	x2 := x * x
+	sum, t := x, x
+	for k := 1; k < maxiter; k++ {
+		t *= x2 / float64(k)
+		del := t / float64(2*k+1)
+		sum += del
+		if math.Abs(del) < math.Abs(sum)*tol {
+			break
+		}
+	}
+	return 2 / math.SqrtPi * sum
+}
@@ -0,0 +1,40 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestErfi(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{inf, inf},
+		{-inf, -inf},
+		{0, 0},
+		{27, inf},
+		{1e-10, 1.1283791670955126e-10},
+		{0.1, 0.11321517416959979},
+		{-0.5, -0.61495209469651102},
+		{1, 1.6504257587975428},
+		{1.999, 18.503318029749735},
+		{2.001, 18.626533229513395},
+		{-3, -1629.9946226015657},
+		{5, 8298273880.6768036},
+		{10, 1.5243074227086696e+42},
+		{20, 1.4747975396287862e+172},
+		{26.5, 2.0501652832248794e+303},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Erfi(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
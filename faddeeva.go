@@ -0,0 +1,171 @@
+package special
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// The implementation of faddeeva follows Algorithm 680 of:
+// G. P. M. Poppe and C. M. J. Wijers. More efficient computation of the complex error function.
+// ACM Transactions on Mathematical Software 16, 38–46 (1990).
+
+// Faddeeva returns the Faddeeva function, or scaled complex complementary error function,
+// defined by
+//
+//	                                           ∞
+//	Faddeeva(z) = Exp(-z**2) Erfc(-iz) = (i/π) ∫ dt Exp(-t**2) / (z - t),   Im(z) > 0
+//	                                          t=-∞
+//
+// The Faddeeva function satisfies Faddeeva(-z) = 2 Exp(-z**2) - Faddeeva(z) and
+// Faddeeva(conj(z)) = conj(Faddeeva(-z)).
+//
+// See http://mathworld.wolfram.com/FaddeevaFunction.html for more information.
+func Faddeeva(z complex128) complex128 {
+	x, y := real(z), imag(z)
+
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsNaN(y):
+		return cmplx.NaN()
+	case math.IsInf(x, 0) && y >= 0, math.IsInf(y, 1):
+		return 0
+	}
+
+	u, v := faddeeva(x, y)
+	return complex(u, v)
+}
+
+// faddeeva returns the real and imaginary parts of Faddeeva(x + iy) for finite x and y.
+//
+// For small |z|, the power series
+//
+//	              ∞
+//	Faddeeva(z) = ∑ (iz)**k / Gamma(k/2 + 1)
+//	             k=0
+//
+// is used, summed in the form Exp(-z**2) [1 + 2iz/Sqrt(π) ∑ z**(2k) / (k! (2k+1))]. For large |z|
+// the Laplace continued fraction
+//
+//	Faddeeva(z) = (i/Sqrt(π)) / (z - (1/2) / (z - 1 / (z - (3/2) / (z - 2 / (z - ...)))))
+//
+// is used, and in between a truncated Taylor expansion about z + ih whose derivatives are
+// calculated from the continued fraction. The lower half-plane follows from the reflection
+// Faddeeva(z) = 2 Exp(-z**2) - Faddeeva(-z).
+func faddeeva(x, y float64) (float64, float64) {
+	const (
+		factor   = 2 / math.SqrtPi
+		rmaxreal = 0.5e154
+		rmaxexp  = 708.503061461606
+		rmaxgoni = 3.53711887601422e15
+	)
+
+	xabs := math.Abs(x)
+	yabs := math.Abs(y)
+
+	// Avoid overflow in the squares below. Only the leading term of the continued
+	// fraction contributes, and the lower half-plane overflows.
+	if xabs > rmaxreal || yabs > rmaxreal {
+		if y < 0 {
+			return math.Inf(1), math.Inf(1)
+		}
+		w := complex(0, 1/math.SqrtPi) / complex(x, y)
+		return real(w), imag(w)
+	}
+
+	xs := xabs / 6.3
+	ys := yabs / 4.4
+	qrho := xs*xs + ys*ys
+	xquad := xabs*xabs - yabs*yabs
+	yquad := 2 * xabs * yabs
+
+	var u, v, u2, v2 float64
+	series := qrho < 0.085264
+	if series {
+		// Power series.
+		qrho = (1 - 0.85*ys) * math.Sqrt(qrho)
+		n := int(math.Round(6 + 72*qrho))
+		j := 2*n + 1
+		xsum := 1 / float64(j)
+		ysum := 0.0
+		for i := n; i >= 1; i-- {
+			j -= 2
+			fi := float64(i)
+			xaux := (xsum*xquad - ysum*yquad) / fi
+			ysum = (xsum*yquad + ysum*xquad) / fi
+			xsum = xaux + 1/float64(j)
+		}
+		u1 := 1 - factor*(xsum*yabs+ysum*xabs)
+		v1 := factor * (xsum*xabs - ysum*yabs)
+		e := math.Exp(-xquad)
+		sin, cos := math.Sincos(yquad)
+		u2 = e * cos
+		v2 = -e * sin
+		u = u1*u2 - v1*v2
+		v = u1*v2 + v1*u2
+	} else {
+		// Continued fraction, with a Taylor expansion about z + ih for 0 < h < 1.88.
+		var h, h2, qlambda float64
+		var kapn, nu int
+		if qrho > 1 {
+			qrho = math.Sqrt(qrho)
+			nu = int(3 + 1442/(26*qrho+77))
+		} else {
+			qrho = (1 - ys) * math.Sqrt(1-qrho)
+			h = 1.88 * qrho
+			h2 = 2 * h
+			kapn = int(math.Round(7 + 34*qrho))
+			nu = int(math.Round(16 + 26*qrho))
+			qlambda = math.Pow(h2, float64(kapn))
+		}
+
+		var rx, ry, sx, sy float64
+		for n := nu; n >= 0; n-- {
+			np1 := float64(n + 1)
+			tx := yabs + h + np1*rx
+			ty := xabs - np1*ry
+			c := 0.5 / (tx*tx + ty*ty)
+			rx = c * tx
+			ry = c * ty
+			if h > 0 && n <= kapn {
+				tx = qlambda + sx
+				sx = rx*tx - ry*sy
+				sy = ry*tx + rx*sy
+				qlambda /= h2
+			}
+		}
+
+		if h == 0 {
+			u, v = factor*rx, factor*ry
+		} else {
+			u, v = factor*sx, factor*sy
+		}
+		if yabs == 0 {
+			u = math.Exp(-xabs * xabs)
+		}
+	}
+
+	// Extend from the first quadrant to the whole plane.
+	if y < 0 {
+		if series {
+			u2 *= 2
+			v2 *= 2
+		} else {
+			xquad = -xquad
+			if yquad > rmaxgoni || xquad > rmaxexp {
+				return math.Inf(1), math.Inf(1)
+			}
+			e := 2 * math.Exp(xquad)
+			sin, cos := math.Sincos(yquad)
+			u2 = e * cos
+			v2 = -e * sin
+		}
+		u = u2 - u
+		v = v2 - v
+		if x > 0 {
+			v = -v
+		}
+	} else if x < 0 {
+		v = -v
+	}
+	return u, v
+}
@@ -0,0 +1,48 @@
+package special_test
+
+import (
+	"fmt"
+	"math/cmplx"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestFaddeeva(t *testing.T) {
+	cases := []struct {
+		In, Out complex128
+	}{
+		{cmplx.NaN(), cmplx.NaN()},
+		{complex(inf, 1), 0},
+		{complex(1, inf), 0},
+		{complex(0, 0), complex(1, 0)},
+		{complex(0.001, 0.001), complex(0.99887162233541127, 0.0011263806715998664)},
+		{complex(0.3, 0.1), complex(0.82724600691453054, 0.26959988704429777)},
+		{complex(1, 0), complex(0.36787944117144233, 0.60715770584139372)},
+		{complex(1, 1), complex(0.30474420525691259, 0.20821893820283163)},
+		{complex(1.5, 0.5), complex(0.19663603224358195, 0.33772031834688793)},
+		{complex(2.5, 0.001), complex(0.002060667855708547, 0.25171329850488511)},
+		{complex(3, 2), complex(0.092710766426443339, 0.12831696222826158)},
+		{complex(4, 4.5), complex(0.070728689086285615, 0.061173591935966803)},
+		{complex(5.5, 0), complex(7.2877240958196922e-14, 0.1043674364367812)},
+		{complex(7, 0.1), complex(0.0011883327469052374, 0.081429970895348619)},
+		{complex(20, 1), complex(0.0014122347663929661, 0.028173995667521982)},
+		{complex(0, 8), complex(0.069985166200880924, 0)},
+		{complex(-1, 2), complex(0.21849261527489069, -0.092997809392601868)},
+		{complex(-3, 0.5), complex(0.037126366054692342, -0.19298375530036208)},
+		{complex(-9, 0.001), complex(7.0984538794651439e-06, -0.063082089255262611)},
+		{complex(1, -0.5), complex(0.15554114245433107, 1.1378372157816863)},
+		{complex(-2, -1), complex(-0.20532558064658751, -0.1468554850301674)},
+		{complex(3, -2), complex(-0.081339079928627364, 0.12108616246299844)},
+		{complex(0.2, -0.3), complex(1.3736209932277057, 0.38643856152072664)},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Faddeeva(c.In)
+			ok := equalFloat64(real(res), real(c.Out)) && equalFloat64(imag(res), imag(c.Out))
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package special
+
+import "math"
+
+// FermiDirac returns the complete Fermi-Dirac integral of order j, defined by
+//
+//	                                       ∞
+//	FermiDirac(j, η) = [1 / Gamma(j+1)] ∫ dt t**j / (Exp(t-η) + 1)
+//	                                      t=0
+//
+// for j > -1, and by analytic continuation, FermiDirac(j, η) = -Polylog(j+1, -Exp(η)), for all
+// real j. The normalisation is such that d/dη FermiDirac(j, η) = FermiDirac(j-1, η).
+//
+// See 25.12(iii), Digital Library of Mathematical Functions (https://dlmf.nist.gov/25.12#iii)
+// for more information.
+func FermiDirac(j, eta float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(j) || math.IsNaN(eta) || math.IsInf(j, 0):
+		return math.NaN()
+	case math.IsInf(eta, -1):
+		return 0
+	case math.IsInf(eta, 1):
+		switch {
+		case j > -1:
+			return math.Inf(1)
+		case j == -1:
+			return 1
+		}
+		return 0
+	case eta == 0:
+		return Eta(j + 1)
+	}
+
+	const (
+		etamin = -1
+		etamax = 2
+		etaasy = 40
+	)
+
+	switch {
+	case eta < etamin:
+		return fermi_dirac_series(j, eta)
+	case eta > 0 && j < -1 && j == math.Trunc(j):
+		// The Sommerfeld expansion vanishes identically for integer j < -1.
+		return cosPi(j) * FermiDirac(j, -eta)
+	case eta > 0 && j == math.Trunc(j):
+		// The Sommerfeld expansion terminates for integer j ≥ -1, and is exact.
+		return fermi_dirac_sommerfeld(j, eta) + cosPi(j)*FermiDirac(j, -eta)
+	case eta <= etamax:
+		return fermi_dirac_taylor(j, eta)
+	case j > -1:
+		return fermi_dirac_split(j, eta)
+	case eta > etaasy:
+		return fermi_dirac_sommerfeld(j, eta) + cosPi(j)*FermiDirac(j, -eta)
+	}
+	return -Polylog(j+1, -math.Exp(eta))
+}
+
+// FermiDiracInc returns the incomplete Fermi-Dirac integral of order j, defined by
+//
+//	                                             ∞
+//	FermiDiracInc(j, η, b) = [1 / Gamma(j+1)] ∫ dt t**j / (Exp(t-η) + 1)
+//	                                            t=b
+//
+// for j > -1 and b ≥ 0, such that FermiDiracInc(j, η, 0) = FermiDirac(j, η).
+//
+// See 25.12(iii), Digital Library of Mathematical Functions (https://dlmf.nist.gov/25.12#iii)
+// for more information.
+func FermiDiracInc(j, eta, b float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(j) || math.IsNaN(eta) || math.IsNaN(b) || math.IsInf(j, 0) || j <= -1 || b < 0:
+		return math.NaN()
+	case b == 0:
+		return FermiDirac(j, eta)
+	case math.IsInf(b, 1) || math.IsInf(eta, -1):
+		return 0
+	case math.IsInf(eta, 1):
+		return math.Inf(1)
+	}
+
+	// The terms of both alternating series below are moment sequences, so their sums
+	// converge rapidly with alternatingSum.
+	const n = 25
+
+	if b >= eta {
+		// Expand 1 / (Exp(t-η) + 1) in powers of Exp(η-t), integrating term by term.
+		return alternatingSum(n, func(k int) float64 {
+			fk := float64(k + 1)
+			return math.Exp(-fk*(b-eta)) * math.Pow(fk, -j-1) * gammaQ_scaled(j+1, fk*b)
+		})
+	}
+
+	// Subtract the integral over [0, b] from the complete integral, expanding
+	// 1 / (Exp(t-η) + 1) = 1 - 1 / (Exp(η-t) + 1) in powers of Exp(t-η).
+	sum := alternatingSum(n, func(k int) float64 {
+		fk := float64(k + 1)
+		return math.Exp(-fk*(eta-b)) * fermi_dirac_phi(j, fk*b)
+	})
+	lg, _ := math.Lgamma(j + 1)
+	p := math.Exp((j+1)*math.Log(b) - lg)
+	return FermiDirac(j, eta) - p*(1/(j+1)-sum)
+}
+
+// fermi_dirac_series returns FermiDirac(j, η) for η < 0 using the series
+//
+//	                   ∞
+//	FermiDirac(j, η) = ∑ (-1)**(k+1) Exp(kη) / k**(j+1)
+//	                  k=1
+func fermi_dirac_series(j, eta float64) float64 {
+	const (
+		maxiter = 1000
+		tol     = 1e-17
+	)
+
+	res := 0.0
+	s := 1.0
+	for k := 1.0; k < maxiter; k++ {
+		term := s * math.Exp(k*eta-(j+1)*math.Log(k))
+		res += term
+		if math.Abs(term) < tol*math.Abs(res) {
+			break
+		}
+		s = -s
+	}
+	return res
+}
+
+// fermi_dirac_taylor returns FermiDirac(j, η) for |η| < π using the Taylor series
+//
+//	                   ∞
+//	FermiDirac(j, η) = ∑ Eta(j+1-k) η**k / k!
+//	                  k=0
+//
+// where Eta is the Dirichlet eta function.
+func fermi_dirac_taylor(j, eta float64) float64 {
+	const (
+		maxiter = 500
+		tol     = 1e-17
+	)
+
+	res := 0.0
+	c := 1.0 // η**k / k!
+	for k := 0.0; k < maxiter; k++ {
+		term := c * Eta(j+1-k)
+		res += term
+		// Eta(j+1-k) vanishes at the negative even integers, so only non-zero terms are tested.
+		if k > j+1 && term != 0 && math.Abs(term) < tol*math.Abs(res) {
+			break
+		}
+		c *= eta / (k + 1)
+	}
+	return res
+}
+
+// fermi_dirac_sommerfeld returns the Sommerfeld expansion of FermiDirac(j, η) for η > 0
+//
+//	                                         ∞
+//	FermiDirac(j, η) ~ η**(j+1) / Gamma(j+2) ∑ 2 Eta(2k) Gamma(j+2) / Gamma(j+2-2k) η**(-2k)
+//	                                        k=0
+//
+// where Eta is the Dirichlet eta function, which differs from FermiDirac(j, η) by
+// Cos(πj) FermiDirac(j, -η). For integer j ≥ -1 the series terminates, otherwise it is
+// asymptotic and is truncated at its smallest term, with an error of order Exp(-η).
+func fermi_dirac_sommerfeld(j, eta float64) float64 {
+	const (
+		maxiter = 100
+		tol     = 1e-17
+	)
+
+	isint := j == math.Trunc(j)
+	x := 1 / (eta * eta)
+	res := 1.0
+	xk := 1.0
+	prev := math.Inf(1)
+	for k := 1; k < maxiter; k++ {
+		fk := float64(2 * k)
+		if isNonPosInt(j + 2 - fk) {
+			break
+		}
+		xk *= x
+		term := 2 * Eta(fk) * GammaRatio([]float64{j + 2}, []float64{j + 2 - fk}) * xk
+		if !isint && math.Abs(term) > prev {
+			break
+		}
+		res += term
+		if math.Abs(term) < tol*math.Abs(res) {
+			break
+		}
+		prev = math.Abs(term)
+	}
+	lg, sg := math.Lgamma(j + 2)
+	return float64(sg) * res * math.Exp((j+1)*math.Log(eta)-lg)
+}
+
+// fermi_dirac_phi returns the integral
+//
+//	              1
+//	phi(j, x) = ∫ du (1-u)**j Exp(-xu) = Exp(-x) ∑ x**n / (n! (j+n+1))
+//	             u=0                              n
+//
+// for j > -1 and x ≥ 0, using the series for small x and the asymptotic expansion
+//
+//	phi(j, x) ~ ∑ (-1)**m j(j-1)...(j-m+1) / x**(m+1)
+//	            m
+//
+// for large x.
+func fermi_dirac_phi(j, x float64) float64 {
+	const (
+		maxiter = 2000
+		tol     = 1e-17
+		xmin    = 40
+	)
+
+	if x < xmin+2*math.Abs(j) && x <= 700 {
+		res := 0.0
+		c := 1.0 // x**n / n!
+		for n := 0.0; n < maxiter; n++ {
+			term := c / (j + n + 1)
+			res += term
+			if n > x && term < tol*res {
+				break
+			}
+			c *= x / (n + 1)
+		}
+		return math.Exp(-x) * res
+	}
+
+	res := 0.0
+	term := 1 / x
+	for m := 0.0; m < maxiter; m++ {
+		res += term
+		next := term * (m - j) / x
+		if math.Abs(term) < tol*math.Abs(res) || math.Abs(next) > math.Abs(term) {
+			break
+		}
+		term = next
+	}
+	return res
+}
+
+// fermi_dirac_split returns FermiDirac(j, η) for j > -1 and η > 0 by splitting the integral at
+// t = η and expanding 1 / (Exp(t-η) + 1) in powers of Exp(-|t-η|) on each side, which gives
+//
+//	                   ∞                                                     ∞
+//	FermiDirac(j, η) = ∑ (-1)**(k+1) k**(-j-1) Exp(kη) GammaRegQ(j+1, kη) + P ∑ (-1)**k phi(j, kη)
+//	                  k=1                                                    k=0
+//
+// where P = η**(j+1) / Gamma(j+1) and phi(j, 0) = 1 / (j+1). As in FermiDiracInc, the terms of
+// both sums are moment sequences, and nothing cancels even for large j, unlike the inversion
+// formula of PolylogComplex.
+func fermi_dirac_split(j, eta float64) float64 {
+	const n = 25
+
+	upper := alternatingSum(n, func(k int) float64 {
+		fk := float64(k + 1)
+		return math.Pow(fk, -j-1) * gammaQ_scaled(j+1, fk*eta)
+	})
+	lower := alternatingSum(n, func(k int) float64 {
+		return fermi_dirac_phi(j, float64(k+1)*eta)
+	})
+	lg, _ := math.Lgamma(j + 1)
+	p := math.Exp((j+1)*math.Log(eta) - lg)
+	return upper + p*(1/(j+1)-lower)
+}
@@ -0,0 +1,87 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestFermiDirac(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 1, nan},
+		{0.5, nan, nan},
+		{inf, 1, nan},
+		{0.5, -inf, 0},
+		{0.5, inf, inf},
+		{-1, inf, 1},
+		{-2, inf, 0},
+		{0, 0, 0.6931471805599453},
+		{0.5, -3, 0.04893370569649578},
+		{0.5, 0.5, 1.1173314873128224},
+		{0.5, 1.9, 2.6793913048201023},
+		{0.5, 5, 8.844208895242954},
+		{0.5, 30, 123.77734775009833},
+		{0.5, 50, 266.0928125213626},
+		{-0.5, 2, 1.464294589087629},
+		{-0.5, 45, 7.567857081040255},
+		{1.5, 10, 101.00510084332601},
+		{2.5, -1.5, 0.21894951784609296},
+		{3, 4, 25.701910112554327},
+		{1, -0.5, 0.5332172799948812},
+		{0, 3, 3.048587351573742},
+		{5.5, 60, 196300969.10171527},
+		{-1, 2, 0.8807970779778824},
+		{-2, 3, 0.04517665973091213},
+		{-1.5, -2, 0.11314384661737205},
+		{9.5, 5, 140.36876643787889},
+		{14.5, 10, 20014.772035319518},
+		{19.5, 10, 21856.288006618164},
+		{30.5, 10, 22026.311727839973},
+		{30.5, 30, 6218776462188.2441},
+		{19.5, 40, 95674897872019.016},
+		{50.5, 45, 2.8234232791319003e+19},
+		{99.5, 20, 485165195.40979028},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := FermiDirac(c.In1, c.In2)
+			if !equalFloat64(res, c.Out) {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestFermiDiracInc(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, Out float64
+	}{
+		{nan, 1, 1, nan},
+		{0.5, nan, 1, nan},
+		{0.5, 1, nan, nan},
+		{-1, 1, 1, nan},
+		{0.5, 1, -1, nan},
+		{0.5, 1, inf, 0},
+		{0.5, -inf, 1, 0},
+		{0.5, 0.5, 0, 1.1173314873128224},
+		{0.5, 2, 1, 2.222719308942703},
+		{0.5, 2, 3, 0.7091032674110663},
+		{0.5, 2, 2, 1.378784180422769},
+		{1.5, 10, 8, 48.30730308684783},
+		{1.5, 10, 12, 4.502082076132263},
+		{-0.5, 1, 0.1, 0.7685679109774727},
+		{2, -2, 5, 0.016863479562626554},
+		{0.5, 50, 49, 10.516861326683964},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := FermiDiracInc(c.In1, c.In2, c.In3)
+			if !equalFloat64(res, c.Out) {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package special
+
+import "math"
+
+// FresnelS returns the Fresnel sine integral, defined by
+//
+//	              x
+//	FresnelS(x) = ∫ dt Sin(π t**2 / 2) = 1/2 - FresnelF(x) Cos(π x**2 / 2) - FresnelG(x) Sin(π x**2 / 2)
+//	             t=0
+//
+// See http://mathworld.wolfram.com/FresnelIntegrals.html for more information.
+func FresnelS(x float64) float64 {
+	const xsmall = 1
+
+	// Special cases.
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case math.IsInf(x, 0):
+		return math.Copysign(0.5, x)
+	}
+
+	xabs := math.Abs(x)
+	if xabs < xsmall {
+		s, _ := fresnel_series(x)
+		return s
+	}
+	f, g := fresnel_fg(xabs)
+	sin, cos := fresnel_sincos(xabs)
+	return math.Copysign(0.5-f*cos-g*sin, x)
+}
+
+// FresnelC returns the Fresnel cosine integral, defined by
+//
+//	              x
+//	FresnelC(x) = ∫ dt Cos(π t**2 / 2) = 1/2 + FresnelF(x) Sin(π x**2 / 2) - FresnelG(x) Cos(π x**2 / 2)
+//	             t=0
+//
+// See http://mathworld.wolfram.com/FresnelIntegrals.html for more information.
+func FresnelC(x float64) float64 {
+	const xsmall = 1
+
+	// Special cases.
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case math.IsInf(x, 0):
+		return math.Copysign(0.5, x)
+	}
+
+	xabs := math.Abs(x)
+	if xabs < xsmall {
+		_, c := fresnel_series(x)
+		return c
+	}
+	f, g := fresnel_fg(xabs)
+	sin, cos := fresnel_sincos(xabs)
+	return math.Copysign(0.5+f*sin-g*cos, x)
+}
+
+// FresnelF returns the auxiliary Fresnel function f, defined by
+//
+//	FresnelF(x) = [1/2 - FresnelS(x)] Cos(π x**2 / 2) - [1/2 - FresnelC(x)] Sin(π x**2 / 2)
+//
+// FresnelF(x) ~ 1 / (π x) as x -> +∞.
+//
+// See http://mathworld.wolfram.com/FresnelIntegrals.html for more information.
+func FresnelF(x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, -1):
+		return math.NaN()
+	case math.IsInf(x, 1):
+		return 0
+	}
+
+	f, _ := fresnel_fg(math.Abs(x))
+	if x < 0 {
+		sin, cos := fresnel_sincos(x)
+		f = cos - sin - f
+	}
+	return f
+}
+
+// FresnelG returns the auxiliary Fresnel function g, defined by
+//
+//	FresnelG(x) = [1/2 - FresnelC(x)] Cos(π x**2 / 2) + [1/2 - FresnelS(x)] Sin(π x**2 / 2)
+//
+// FresnelG(x) ~ 1 / (π**2 x**3) as x -> +∞.
+//
+// See http://mathworld.wolfram.com/FresnelIntegrals.html for more information.
+func FresnelG(x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, -1):
+		return math.NaN()
+	case math.IsInf(x, 1):
+		return 0
+	}
+
+	_, g := fresnel_fg(math.Abs(x))
+	if x < 0 {
+		sin, cos := fresnel_sincos(x)
+		g = cos + sin - g
+	}
+	return g
+}
+
+// fresnel_fg returns the auxiliary functions FresnelF(x) and FresnelG(x) for x ≥ 0.
+func fresnel_fg(x float64) (float64, float64) {
+	const (
+		xsmall = 1
+		xlarge = 5
+	)
+
+	switch {
+	case x < xsmall:
+		s, c := fresnel_series(x)
+		sin, cos := fresnel_sincos(x)
+		return (0.5-s)*cos - (0.5-c)*sin, (0.5-c)*cos + (0.5-s)*sin
+	case x >= xlarge:
+		return fresnel_asymptotic(x)
+	}
+
+	// FresnelG(x) + i FresnelF(x) = (1+i)/2 Faddeeva((1+i) Sqrt(π) x / 2).
+	a := math.SqrtPi / 2 * x
+	u, v := faddeeva(a, a)
+	return (u + v) / 2, (u - v) / 2
+}
+
+// fresnel_asymptotic returns FresnelF(x) and FresnelG(x) using the asymptotic expansions
+// for large x, i.e.
+//
+//	                     ∞
+//	FresnelF(x) ~ 1/(πx) ∑ (-1)**m 1.3...(4m-1) / (π x**2)**(2m)
+//	                    m=0
+//
+//	                            ∞
+//	FresnelG(x) ~ 1/(π**2 x**3) ∑ (-1)**m 1.3...(4m+1) / (π x**2)**(2m)
+//	                           m=0
+//
+// See 7.3.27-7.3.28, p302, Abramowitz & Stegun.
+func fresnel_asymptotic(x float64) (float64, float64) {
+	const (
+		maxiter = 100
+		tol     = 1e-17
+	)
+
+	y := 1 / (math.Pi * x * x)
+	y2 := y * y
+	sf, sg := 1.0, 1.0
+	tf, tg := 1.0, 1.0
+	for m := 1; m < maxiter; m++ {
+		k := float64(4 * m)
+		tfnext := -tf * (k - 3) * (k - 1) * y2
+		// Stop when the series starts to diverge.
+		if math.Abs(tfnext) > math.Abs(tf) {
+			break
+		}
+		tf = tfnext
+		tg = -tg * (k - 1) * (k + 1) * y2
+		sf += tf
+		sg += tg
+		if math.Abs(tf) < tol && math.Abs(tg) < tol {
+			break
+		}
+	}
+	return sf / (math.Pi * x), sg * y / (math.Pi * x)
+}
+
+// fresnel_series returns FresnelS(x) and FresnelC(x) using the power series
+//
+//	              ∞
+//	FresnelS(x) = ∑ (-1)**n (π/2)**(2n+1) x**(4n+3) / [(2n+1)! (4n+3)]
+//	             n=0
+//
+//	              ∞
+//	FresnelC(x) = ∑ (-1)**n (π/2)**(2n) x**(4n+1) / [(2n)! (4n+1)]
+//	             n=0
+//
+// See 7.3.11-7.3.13, p301, Abramowitz & Stegun.
+func fresnel_series(x float64) (float64, float64) {
+	const (
+		maxiter = 100
+		tol     = 1e-17
+	)
+
+	z := math.Pi / 2 * x * x
+	// t = (-1)**n z**(2n) / (2n)! and (-1)**n z**(2n+1) / (2n+1)! respectively.
+	tc, ts := 1.0, z
+	c, s := 1.0, z/3
+	for n := 1; n < maxiter; n++ {
+		k := float64(2 * n)
+		tc = -ts * z / k
+		ts = tc * z / (k + 1)
+		dc := tc / (2*k + 1)
+		ds := ts / (2*k + 3)
+		c += dc
+		s += ds
+		if math.Abs(dc) < math.Abs(c)*tol && math.Abs(ds) < math.Abs(s)*tol {
+			break
+		}
+	}
+	return x * s, x * c
+}
+
+// fresnel_sincos returns Sin(π x**2 / 2) and Cos(π x**2 / 2), reducing the argument exactly
+// to avoid the loss of accuracy for large x.
+func fresnel_sincos(x float64) (float64, float64) {
+	// For |x| ≥ 2**53, x is an even integer, and so x**2 / 2 is also even.
+	if math.Abs(x) >= 1<<53 {
+		return 0, 1
+	}
+
+	// x**2 = p + e exactly.
+	p := x * x
+	e := math.FMA(x, x, -p)
+	t := math.Mod(p/2, 2) + e/2
+	return sinPi(t), cosPi(t)
+}
@@ -0,0 +1,152 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestFresnelS(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{inf, 0.5},
+		{-inf, -0.5},
+		{0, 0},
+		{1e-05, 5.2359877559829902e-16},
+		{0.3, 0.014116998006576583},
+		{-0.7, -0.17213645786347742},
+		{0.999, 0.43725914903405455},
+		{1, 0.43825914739035476},
+		{1.5, 0.69750496008209306},
+		{2.2, 0.45570461212465707},
+		{-3, -0.49631299896737502},
+		{4, 0.42051575424692844},
+		{4.99, 0.48923239307306765},
+		{5.01, 0.50915024772902462},
+		{6, 0.44696076123693029},
+		{8, 0.46021421439301446},
+		{10.5, 0.52804040799812979},
+		{15, 0.49996997980970276},
+		{25, 0.49999351546947618},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := FresnelS(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestFresnelC(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{inf, 0.5},
+		{-inf, -0.5},
+		{0, 0},
+		{1e-05, 1.0000000000000001e-05},
+		{0.3, 0.29940097605204719},
+		{-0.7, -0.65965235190451033},
+		{0.999, 0.77989183010538521},
+		{1, 0.77989340037682287},
+		{1.5, 0.44526117603982152},
+		{2.2, 0.63628604490331953},
+		{-3, -0.60572078929768558},
+		{4, 0.49842603303817762},
+		{4.99, 0.56284792385513183},
+		{5.01, 0.56284688439777431},
+		{6, 0.49953146785550112},
+		{8, 0.49980218037719715},
+		{10.5, 0.48848000730270918},
+		{15, 0.52122053167437343},
+		{25, 0.51273238553977019},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := FresnelC(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestFresnelF(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{inf, 0},
+		{-inf, nan},
+		{0, 0.5},
+		{1e-05, 0.49999999992146121},
+		{0.3, 0.45277101725608732},
+		{-0.7, -0.32433804521155968},
+		{0.999, 0.28008745759620124},
+		{1, 0.27989340037682281},
+		{1.5, 0.20341843122601397},
+		{2.2, 0.14302018320281826},
+		{-3, -1.1057207892976857},
+		{4, 0.079484245753071572},
+		{4.99, 0.06375845945815195},
+		{5.01, 0.063504423593575832},
+		{6, 0.053039238763069721},
+		{8, 0.039785785606985515},
+		{10.5, 0.030314469378957876},
+		{15, 0.021220531674373457},
+		{25, 0.012732385539770193},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := FresnelF(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestFresnelG(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{inf, 0},
+		{-inf, nan},
+		{0, 0.5},
+		{1e-05, 0.49999000007853983},
+		{0.3, 0.26705929298172787},
+		{-0.7, 1.3005252122489854},
+		{0.999, 0.061861676642077555},
+		{1, 0.061740852609645236},
+		{1.5, 0.025009796942798094},
+		{2.2, 0.009010805682567815},
+		{-3, 0.99631299896737502},
+		{4, 0.0015739669618223845},
+		{4.99, 0.00081347363606257554},
+		{5.01, 0.00080380100610319655},
+		{6, 0.00046853214449887979},
+		{8, 0.00019781962280286444},
+		{10.5, 8.7514109935362086e-05},
+		{15, 3.0020190297256577e-05},
+		{25, 6.4845305238438932e-06},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := FresnelG(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
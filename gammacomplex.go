@@ -0,0 +1,278 @@
+package special
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// The branch structure of LgammaComplex follows:
+// D. E. G. Hare. Computing the principal branch of log-Gamma.
+// Journal of Algorithms 25, 221–236 (1997).
+
+// GammaComplex returns the Gamma function for complex z, defined by
+//
+//	                  ∞
+//	GammaComplex(z) = ∫ dt t**(z-1) Exp(-t),   Re(z) > 0
+//	                 t=0
+//
+// and by analytic continuation elsewhere, using the reflection formula
+//
+//	GammaComplex(z) GammaComplex(1-z) = π / Sin(πz)
+//
+// GammaComplex has simple poles at z = 0, -1, -2, ...
+//
+// See http://mathworld.wolfram.com/GammaFunction.html for more information.
+func GammaComplex(z complex128) complex128 {
+	x, y := real(z), imag(z)
+
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsNaN(y):
+		return cmplx.NaN()
+	case y == 0 && isNonPosInt(x):
+		return cmplx.Inf()
+	case y == 0:
+		return complex(math.Gamma(x), 0)
+	}
+	return cmplx.Exp(LgammaComplex(z))
+}
+
+// LgammaComplex returns the principal branch of the logarithm of the Gamma function for
+// complex z, defined by
+//
+//	                                            ∞
+//	LgammaComplex(z) = -EulerGamma z - Log(z) + ∑ [z/k - Log(1 + z/k)]
+//	                                           k=1
+//
+// which is analytic in the complex plane cut along the negative real axis and agrees with
+// Lgamma(x) for real x > 0. Unlike Log(GammaComplex(z)), it has no branch cuts away from
+// the negative real axis, and is continuous onto the cut from above, i.e. for real x < 0,
+//
+//	LgammaComplex(x) = Lgamma(x) - iπ Ceil(-x)
+//
+// See http://mathworld.wolfram.com/LogGammaFunction.html for more information.
+func LgammaComplex(z complex128) complex128 {
+	x, y := real(z), imag(z)
+
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsNaN(y):
+		return cmplx.NaN()
+	case math.IsInf(x, 0) || math.IsInf(y, 0):
+		return cmplx.Inf()
+	case y == 0 && isNonPosInt(x):
+		return cmplx.Inf()
+	case y == 0:
+		lg, _ := math.Lgamma(x)
+		if x > 0 {
+			return complex(lg, 0)
+		}
+		// The imaginary part takes the sign of the (signed) zero y.
+		return complex(lg, -math.Copysign(math.Pi*math.Ceil(-x), y))
+	}
+
+	const (
+		xmin   = 7
+		radius = 0.2
+	)
+
+	switch {
+	case x >= xmin || math.Abs(y) >= xmin:
+		return lgamma_stirling(z)
+	case cmplx.Abs(z-1) <= radius:
+		return lgamma_taylor(z - 1)
+	case cmplx.Abs(z-2) <= radius:
+		// Log(Gamma(2+w)) = Log(Gamma(1+w)) + Log(1+w), with Log(1+w) computed accurately.
+		w := z - 2
+		u, v := real(w), imag(w)
+		return lgamma_taylor(w) + complex(math.Log1p(u*(2+u)+v*v)/2, math.Atan2(v, 1+u))
+	case x < 0.1:
+		// Reflection formula, Log(Gamma(z)) = Log(π) - Log(Sin(πz)) - Log(Gamma(1-z)),
+		// with the multiple of 2πi chosen so that the result is the principal branch.
+		k := math.Floor(x/2 + 0.25)
+		return complex(math.Log(math.Pi), math.Copysign(2*math.Pi, y)*k) -
+			cmplx.Log(sinPiComplex(z)) - LgammaComplex(1-z)
+	case y < 0:
+		return cmplx.Conj(lgamma_recurrence(cmplx.Conj(z), xmin))
+	}
+	return lgamma_recurrence(z, xmin)
+}
+
+// lgamma_recurrence returns LgammaComplex(z) for Im(z) > 0, using the recurrence relation
+//
+//	Log(Gamma(z)) = Log(Gamma(z+n)) - Log(z (z+1) ... (z+n-1))
+//
+// to increment z until Re(z) ≥ xmin. The logarithm of the product is corrected by 2πi
+// each time the product crosses the negative real axis.
+func lgamma_recurrence(z complex128, xmin float64) complex128 {
+	prod := z
+	signflips := 0.0
+	sb := false
+	for z++; real(z) < xmin; z++ {
+		prod *= z
+		nsb := math.Signbit(imag(prod))
+		if nsb && !sb {
+			signflips++
+		}
+		sb = nsb
+	}
+	return lgamma_stirling(z) - cmplx.Log(prod) - complex(0, 2*math.Pi*signflips)
+}
+
+// lgamma_stirling returns LgammaComplex(z) for large |z| using Stirling's series
+//
+//	Log(Gamma(z)) ~ (z-1/2) Log(z) - z + Log(2π)/2 + ∑ B(2k) / (2k(2k-1) z**(2k-1))
+//
+// where B(n) is the nth Bernoulli number. See 6.1.41, p257, Abramowitz & Stegun.
+func lgamma_stirling(z complex128) complex128 {
+	w := 1 / z
+	return (z-0.5)*cmplx.Log(z) - z + complex(math.Log(2*math.Pi)/2, 0) +
+		w*cpoly(w*w, lgamma_asymptotic_coefficients...)
+}
+
+// lgamma_taylor returns LgammaComplex(1+w) for small |w| using the Taylor series
+//
+//	                                  ∞
+//	Log(Gamma(1+w)) = -EulerGamma w + ∑ (-1)**k Zeta(k) w**k / k
+//	                                 k=2
+//
+// See 6.1.33, p256, Abramowitz & Stegun.
+func lgamma_taylor(w complex128) complex128 {
+	return w * (-EulerGamma + w*cpoly(w, lgamma_taylor_coefficients...))
+}
+
+// DigammaComplex returns the first logarithmic derivative of the Gamma function for
+// complex z, defined by
+//
+//	DigammaComplex(z) = d/dz LgammaComplex(z)
+//
+// DigammaComplex has simple poles at z = 0, -1, -2, ...
+//
+// See http://mathworld.wolfram.com/DigammaFunction.html for more information.
+func DigammaComplex(z complex128) complex128 {
+	x, y := real(z), imag(z)
+
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsNaN(y):
+		return cmplx.NaN()
+	case math.IsInf(x, 0) || math.IsInf(y, 0):
+		return cmplx.Inf()
+	case y == 0:
+		return complex(Digamma(x), 0)
+	}
+
+	const xmin = 10
+
+	// For Re(z) < 1/2, use the reflection formula Digamma(z) = Digamma(1-z) - π Cot(πz).
+	// Far from the real axis, the asymptotic series is used directly instead.
+
+	var res complex128
+	if x < 0.5 && math.Abs(y) < xmin {
+		res = -math.Pi * cosPiComplex(z) / sinPiComplex(z)
+		z = 1 - z
+	}
+
+	// If |z| < xmin, use the recurrence relation Digamma(z+1) = Digamma(z) + 1/z
+	// to increment z until |z| >= xmin.
+
+	for cmplx.Abs(z) < xmin {
+		res -= 1 / z
+		z++
+	}
+
+	// For |z| >= xmin, use the asymptotic series expansion about z = ∞.
+
+	w := 1 / (z * z)
+	return res + cmplx.Log(z) - 0.5/z + w*cpoly(w, digamma_asymptotic_coefficients...)
+}
+
+// TrigammaComplex returns the logarithmic second derivative of the Gamma function for
+// complex z, or, equivalently, the first derivative of DigammaComplex.
+//
+//	TrigammaComplex(z) = d/dz DigammaComplex(z)
+//
+// TrigammaComplex has double poles at z = 0, -1, -2, ...
+//
+// See http://mathworld.wolfram.com/TrigammaFunction.html for more information.
+func TrigammaComplex(z complex128) complex128 {
+	x, y := real(z), imag(z)
+
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsNaN(y):
+		return cmplx.NaN()
+	case math.IsInf(x, 0) || math.IsInf(y, 0):
+		return 0
+	case y == 0:
+		return complex(Trigamma(x), 0)
+	}
+
+	const xmin = 10
+
+	// For Re(z) < 1/2, use the reflection formula Trigamma(z) = π**2 / Sin(πz)**2 - Trigamma(1-z).
+	// Far from the real axis, the asymptotic series is used directly instead.
+
+	var res complex128
+	s := complex(1, 0)
+	if x < 0.5 && math.Abs(y) < xmin {
+		sin := sinPiComplex(z)
+		res = math.Pi * math.Pi / (sin * sin)
+		s = -1
+		z = 1 - z
+	}
+
+	// If |z| < xmin, use the recurrence relation Trigamma(z+1) = Trigamma(z) - 1/z**2
+	// to increment z until |z| >= xmin.
+
+	for cmplx.Abs(z) < xmin {
+		res += s / (z * z)
+		z++
+	}
+
+	// For |z| >= xmin, use the asymptotic series expansion about z = ∞.
+
+	w := 1 / z
+	return res + s*w*(1+w/2+w*w*cpoly(w*w, trigamma_asymptotic_coefficients...))
+}
+
+// The coefficients of Stirling's series for LgammaComplex are
+// lgamma_asymptotic_coefficients[n] = B(2n+2) / ((2n+2)(2n+1))
+// where B(n) is the nth Bernoulli number.
+var lgamma_asymptotic_coefficients = []float64{
+	1. / 12,
+	-1. / 360,
+	1. / 1260,
+	-1. / 1680,
+	1. / 1188,
+	-691. / 360360,
+	1. / 156,
+	-3617. / 122400,
+}
+
+// The coefficients of the Taylor series for LgammaComplex about z = 1 are
+// lgamma_taylor_coefficients[n] = (-1)**n Zeta(n+2) / (n+2).
+var lgamma_taylor_coefficients = []float64{
+	0.8224670334241132,
+	-0.40068563438653143,
+	0.27058080842778454,
+	-0.20738555102867398,
+	0.1695571769974082,
+	-0.1440498967688461,
+	0.12550966952474304,
+	-0.11133426586956469,
+	0.1000994575127818,
+	-0.09095401714582904,
+	0.083353840546109,
+	-0.0769325164113522,
+	0.07143294629536133,
+	-0.06666870588242046,
+	0.06250095514121304,
+	-0.058823978658684585,
+	0.055555767627403614,
+	-0.05263167937961666,
+	0.05000004769810169,
+	-0.047619070330142226,
+	0.04545455629320467,
+	-0.04347826605304026,
+}
@@ -0,0 +1,154 @@
+package special_test
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestGammaComplex(t *testing.T) {
+	cases := []struct {
+		In, Out complex128
+	}{
+		{cmplx.NaN(), cmplx.NaN()},
+		{complex(0, 0), cmplx.Inf()},
+		{complex(-3, 0), cmplx.Inf()},
+		{complex(4, 0), complex(6, 0)},
+		{complex(-3.4, 0), complex(0.32589116089216075, 0)},
+		{complex(1, 0.001), complex(0.9999990109449864, -0.0005772147574234388)},
+		{complex(1.1, -0.1), complex(0.9437393274033538, 0.03972328642513883)},
+		{complex(2.05, 0.1), complex(1.0179350144830377, 0.046365315480703634)},
+		{complex(0.5, 0.5), complex(0.8181639995417473, -0.7633138287139826)},
+		{complex(3, 4), complex(0.0052255384713692146, -0.1725470792943002)},
+		{complex(-0.5, 0.1), complex(-3.3926661426873252, -0.01284755039222288)},
+		{complex(-0.7, 0.01), complex(-4.269702317502037, 0.088514906400277)},
+		{complex(-0.7, -0.01), complex(-4.269702317502037, -0.088514906400277)},
+		{complex(-2.5, 1), complex(-0.04173662580789361, -0.08636910736976348)},
+		{complex(-10.3, 0.5), complex(3.630689029163985e-08, -1.727830220477115e-07)},
+		{complex(-20.7, -3), complex(1.9551793035523227e-23, 2.3692926719211578e-23)},
+		{complex(5, 6.9), complex(0.3508158435760877, -0.166839770172569)},
+		{complex(0.2, 10), complex(1.893264126512733e-07, -2.289553090431207e-09)},
+		{complex(0.001, 0.001), complex(499.4237733891342, -499.9990127569994)},
+		{complex(6.5, 0.3), complex(245.37538668531863, 146.41814071065497)},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := GammaComplex(c.In)
+			ok := equalFloat64(real(res), real(c.Out)) && equalFloat64(imag(res), imag(c.Out))
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestLgammaComplex(t *testing.T) {
+	cases := []struct {
+		In, Out complex128
+	}{
+		{cmplx.NaN(), cmplx.NaN()},
+		{complex(inf, 1), cmplx.Inf()},
+		{complex(-2, 0), cmplx.Inf()},
+		{complex(4, 0), complex(1.791759469228055, 0)},
+		{complex(-3.4, 0), complex(-1.1211918156538385, -12.566370614359172)},
+		{complex(-3.4, math.Copysign(0, -1)), complex(-1.1211918156538385, 12.566370614359172)},
+		{complex(1, 0.001), complex(-8.224667628434743e-07, -0.0005772152642161059)},
+		{complex(1.1, -0.1), complex(-0.057020229038172845, 0.04206654437562742)},
+		{complex(2.05, 0.1), complex(0.018812333627671672, 0.045516944236652764)},
+		{complex(0.5, 0.5), complex(0.11238724280962312, -0.7507292021220507)},
+		{complex(3, 4), complex(-1.7566267846037842, 4.742664438034658)},
+		{complex(-0.5, 0.1), complex(1.2216232551552817, -3.1378058120793657)},
+		{complex(-0.7, 0.01), complex(1.4517589495380205, -3.16232061603133)},
+		{complex(-0.7, -0.01), complex(1.4517589495380205, 3.16232061603133)},
+		{complex(-2.5, 1), complex(-2.3441906524655924, -8.304127986657926)},
+		{complex(-10.3, 0.5), complex(-15.549625455096615, -32.779606196567116)},
+		{complex(-20.7, -3), complex(-51.83717654609986, 57.42953526501725)},
+		{complex(5, 6.9), complex(-0.9455448848998668, 12.12245201278436)},
+		{complex(0.2, 10), complex(-15.479720143643034, 12.554278051801312)},
+		{complex(-50, 8), complex(-171.14161204953356, -127.24130299430784)},
+		{complex(0.001, 0.001), complex(6.560604473837553, -0.7859737349296534)},
+		{complex(6.5, 0.3), complex(5.655082335342634, 0.5379974531743978)},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := LgammaComplex(c.In)
+			ok := equalFloat64(real(res), real(c.Out)) && equalFloat64(imag(res), imag(c.Out))
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestDigammaComplex(t *testing.T) {
+	cases := []struct {
+		In, Out complex128
+	}{
+		{cmplx.NaN(), cmplx.NaN()},
+		{complex(-2, 0), complex(nan, 0)},
+		{complex(10, 0), complex(2.251752589066721, 0)},
+		{complex(1, 0.001), complex(-0.5772144628456666, 0.0016449329845260102)},
+		{complex(1.1, -0.1), complex(-0.41451216861046547, -0.14258349802807205)},
+		{complex(2.05, 0.1), complex(0.4564350934333581, 0.062457585177023996)},
+		{complex(0.5, 0.5), complex(-0.8681073626454773, 1.4406595199775145)},
+		{complex(3, 4), complex(1.550359817333411, 1.0105022091860445)},
+		{complex(-0.5, 0.1), complex(0.040619541255299124, 0.8624770629534108)},
+		{complex(-0.7, 0.01), complex(-2.0704847660462304, 0.1427339247275987)},
+		{complex(-0.7, -0.01), complex(-2.0704847660462304, -0.1427339247275987)},
+		{complex(-2.5, 1), complex(1.1546043967509456, 2.8105638599909457)},
+		{complex(-10.3, 0.5), complex(2.6320289373648262, 3.0023810663896255)},
+		{complex(-20.7, -3), complex(3.0640019619242014, -3.0010417890812713)},
+		{complex(5, 6.9), complex(2.1084769018820393, 0.9923313337797214)},
+		{complex(0.2, 10), complex(2.3026186208258936, 1.6008123745158862)},
+		{complex(-50, 8), complex(3.934381421286287, 2.9844873137459733)},
+		{complex(0.001, 0.001), complex(-500.5755707329952, 500.00164253211767)},
+		{complex(6.5, 0.3), complex(1.7941512613601955, 0.049844319556932803)},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := DigammaComplex(c.In)
+			ok := equalFloat64(real(res), real(c.Out)) && equalFloat64(imag(res), imag(c.Out))
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestTrigammaComplex(t *testing.T) {
+	cases := []struct {
+		In, Out complex128
+	}{
+		{cmplx.NaN(), cmplx.NaN()},
+		{complex(1, inf), 0},
+		{complex(-2, 0), complex(nan, 0)},
+		{complex(1, 0.001), complex(1.6449308198836121, -0.002404109658614218)},
+		{complex(1.1, -0.1), complex(1.411020297555826, 0.18357534894052488)},
+		{complex(2.05, 0.1), complex(0.6230748224222665, -0.037917152019610545)},
+		{complex(0.5, 0.5), complex(0.7838024955409938, -2.3518921986034846)},
+		{complex(3, 4), complex(0.1131531139467917, -0.17968001618417836)},
+		{complex(-0.5, 0.1), complex(8.028394491284775, -0.08230422336374359)},
+		{complex(-0.7, 0.01), complex(14.24784322120642, -0.6927956150738165)},
+		{complex(-0.7, -0.01), complex(14.24784322120642, 0.6927956150738165)},
+		{complex(-2.5, 1), complex(-0.22505115885692253, -0.09792013782312714)},
+		{complex(-10.3, 0.5), complex(0.5462729831175497, 1.5264873246599127)},
+		{complex(-20.7, -3), complex(-0.04623596285147029, 0.006540705114622266)},
+		{complex(5, 6.9), complex(0.06646028077819723, -0.10165500117862607)},
+		{complex(0.2, 10), complex(-0.0030048239337471354, -0.09999325529825619)},
+		{complex(-50, 8), complex(-0.01931664837847379, -0.0030598680648567574)},
+		{complex(0.001, 0.001), complex(1.6425299613170066, -500000.00239762815)},
+		{complex(6.5, 0.3), complex(0.16587452549108814, -0.00825605609431495)},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := TrigammaComplex(c.In)
+			ok := equalFloat64(real(res), real(c.Out)) && equalFloat64(imag(res), imag(c.Out))
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -38,13 +38,13 @@ func GammaRegP(a, x float64) float64 {
 	case math.IsInf(x, 1) || isNonPosInt(a):
 		return 1
 	case a == 1:
-		return 1 - math.Exp(-x)
+		return -math.Expm1(-x)
 	}
 
 	// Use gammaQ as primary function and calculate using
 	// the continued fraction representation.
 	if x > a && !(x < 2 && a > -10) {
-		return 1 - gammaQ_cf(a, x)
+		return 1 - gammaQ_cf(a, x, false)
 	}
 
 	return gammaP_series(a, x)
@@ -89,7 +89,16 @@ func GammaRegQ(a, x float64) float64 {
 		return 1 - gammaP_series(a, x)
 	}
 
-	return gammaQ_cf(a, x)
+	return gammaQ_cf(a, x, false)
+}
+
+// gammaQ_scaled returns Exp(x) GammaRegQ(a, x) for x ≥ 0, which, unlike GammaRegQ, does
+// not underflow for large x.
+func gammaQ_scaled(a, x float64) float64 {
+	if x < a || x < 2 {
+		return math.Exp(x) * GammaRegQ(a, x)
+	}
+	return gammaQ_cf(a, x, true)
 }
 
 // gammaP_series returns GammaRegP using the hypergeometric series definition
@@ -115,36 +124,55 @@ func gammaP_series(a, x float64) float64 {
 	return res
 }
 
-// gammaQ_cf returns GammaRegQ using a continued fraction.
-func gammaQ_cf(a, x float64) float64 {
+// gammaQ_cf returns GammaRegQ using a continued fraction, multiplied by Exp(x) if scaled
+// is true.
+func gammaQ_cf(a, x float64, scaled bool) float64 {
 	lga, sga := math.Lgamma(a)
 	s := math.Copysign(1, x)
 	lx := math.Log(math.Abs(x))
-	xma := x - a
-
-	d := gammaQ_cfdepth(a, x)
-	cf := xma + float64(d<<1+1)
-	for i := d; i > 0; i-- {
-		j := (i-1)<<1 + 1
-		ai := float64(i) * (a - float64(i))
-		bj := xma + float64(j)
-		cf = bj + ai/cf
+	cf := gammaU_cf(a, x)
+	if scaled {
+		x = 0
 	}
 	return s * float64(sga) * math.Exp(a*lx-x-lga) / cf
 }
 
-// gammaQ_cfdepth returns the depth required for convergence for the continued fraction for GammaRegQ.
-func gammaQ_cfdepth(a, x float64) int {
-	switch y := x / a; {
-	case y > 1.5:
-		return 10
-	case y > 1.3:
-		return 20
-	case y > 1.1:
-		return 40
-	case y > 1.05:
-		return 50
-	default:
-		return 100
+// gammaU_cf returns the continued fraction
+//
+//	cf = b[0] + a[1] / (b[1] + a[2] / (b[2] + ...))
+//
+// with a[i] = i(a-i) and b[i] = x-a+2i+1, such that GammaIncU(a, x) = Exp(-x) x**a / cf,
+// using the modified Lentz algorithm.
+func gammaU_cf(a, x float64) float64 {
+	const (
+		maxiter = 2000
+		rtol    = 1e-16
+		tiny    = 1e-300
+	)
+
+	xma := x - a
+	cf := xma + 1
+	if math.Abs(cf) < tiny {
+		cf = tiny
+	}
+	c, d := cf, 0.0
+	for i := 1; i < maxiter; i++ {
+		ai := float64(i) * (a - float64(i))
+		bi := xma + float64(i<<1+1)
+		d = bi + ai*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = bi + ai/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := c * d
+		cf *= delta
+		if math.Abs(delta-1) < rtol {
+			break
+		}
 	}
+	return cf
 }
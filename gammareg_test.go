@@ -22,6 +22,8 @@ func TestGammaRegQ(t *testing.T) {
 		{0, 10, 0},
 		{-10, 4.3, 0},
 		{1, 1, 0.36787944117144233},
+		{0.5, 2, 0.045500263896358417},
+		{0.1, 3, 0.0015652717471143539},
 		{9.99, 20, 0.004954569877748838},
 		{9.99, 200, 1.982646310671054e-72},
 		{0.999, 200, 1.3757812606842123e-87},
@@ -66,6 +68,7 @@ func TestGammaRegP(t *testing.T) {
 		{+inf, 456789, 0},
 		{0, 10, 1},
 		{1, 1, 0.6321205588285577},
+		{1, 1e-20, 1e-20},
 		{1, 10, 0.9999546000702375},
 		{1, 50, 1},
 		{10, 100, 1},
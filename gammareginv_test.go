@@ -0,0 +1,144 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestGammaRegPInv(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 0.5, nan},
+		{1, nan, nan},
+		{0, 0.5, nan},
+		{-1, 0.5, nan},
+		{inf, 0.5, nan},
+		{1, -0.1, nan},
+		{1, 1.1, nan},
+		{2, 0, 0},
+		{2, 1, inf},
+		{0.001, 1e-10, 0},
+		{0.5, 0.3, 0.074235930916272716},
+		{1, 0.5, 0.69314718055994529},
+		{1.5, 1e-10, 2.6046988107172399e-07},
+		{2, 0.95, 4.743864518390577},
+		{5, 0.01, 1.2791060800936029},
+		{10, 0.5, 9.6687146147141316},
+		{30, 0.999, 49.803616534924686},
+		{100, 1e-20, 33.316964857233572},
+		{0.1, 0.7, 0.017427776389281995},
+		{0.01, 0.999, 1.5090841476947499},
+		{3, 1e-300, 1.8171205928321398e-100},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := GammaRegPInv(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestGammaRegQInv(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 0.5, nan},
+		{1, nan, nan},
+		{0, 0.5, nan},
+		{-1, 0.5, nan},
+		{inf, 0.5, nan},
+		{1, -0.1, nan},
+		{1, 1.1, nan},
+		{2, 0, inf},
+		{2, 1, 0},
+		{0.5, 0.3, 0.53709708542879264},
+		{1, 0.5, 0.69314718055994529},
+		{1.5, 1e-10, 24.771077963761833},
+		{2, 0.95, 0.35536151069866223},
+		{5, 0.01, 11.60462557947718},
+		{10, 0.5, 9.6687146147141316},
+		{30, 1e-05, 59.290724382706728},
+		{100, 1e-100, 483.2195302225619},
+		{0.1, 1e-20, 40.447481384592756},
+		{7, 1e-300, 723.71094260458131},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := GammaRegQInv(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestGammaRegPInvA(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 0.5, nan},
+		{1, nan, nan},
+		{0, 0.5, nan},
+		{-1, 0.5, nan},
+		{inf, 0.5, nan},
+		{1, -0.1, nan},
+		{1, 1.1, nan},
+		{2, 0, inf},
+		{2, 1, 0},
+		{0.5, 0.3, 1.2091045774420575},
+		{1, 0.5, 1.3142500103453505},
+		{2, 0.95, 0.53246889223144922},
+		{5, 0.01, 11.350803911221305},
+		{10, 0.5, 10.331353335286403},
+		{30, 1e-05, 56.542123007660472},
+		{100, 0.9, 87.798062489770842},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := GammaRegPInvA(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestGammaRegQInvA(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 0.5, nan},
+		{1, nan, nan},
+		{0, 0.5, nan},
+		{-1, 0.5, nan},
+		{inf, 0.5, nan},
+		{1, -0.1, nan},
+		{1, 1.1, nan},
+		{2, 0, 0},
+		{2, 1, inf},
+		{0.5, 0.3, 0.47333407123064386},
+		{1, 0.5, 1.3142500103453505},
+		{2, 0.95, 5.0476649145049084},
+		{5, 0.01, 1.1775017559953751},
+		{10, 0.5, 10.331353335286403},
+		{30, 1e-05, 10.287942213564813},
+		{100, 0.9, 113.41614797934899},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := GammaRegQInvA(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
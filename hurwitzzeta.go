@@ -0,0 +1,304 @@
+package special
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// HurwitzZeta returns the Hurwitz zeta function, defined by
+//
+//	                    ∞
+//	HurwitzZeta(s, a) = ∑ 1 / (k+a)**s
+//	                   k=0
+//
+// for s > 1 and a > 0, and by analytic continuation for all real s ≠ 1. HurwitzZeta
+// generalises the Riemann zeta function, Zeta(s) = HurwitzZeta(s, 1), and the polygamma
+// functions, Polygamma(n, x) = (-1)**(n+1) n! HurwitzZeta(n+1, x).
+//
+// See http://mathworld.wolfram.com/HurwitzZetaFunction.html for more information.
+func HurwitzZeta(s, a float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(s) || math.IsNaN(a) || math.IsInf(s, -1) || a <= 0:
+		return math.NaN()
+	case s == 1:
+		return math.Inf(1)
+	case math.IsInf(a, 1):
+		if s > 1 {
+			return 0
+		}
+		return math.NaN()
+	case math.IsInf(s, 1):
+		switch {
+		case a < 1:
+			return math.Inf(1)
+		case a == 1:
+			return 1
+		}
+		return 0
+	}
+
+	n := hurwitz_zeta_n(s, a)
+	if s < 0 && n > 0 {
+		// Avoid the cancellation in the Euler-Maclaurin summation formula for negative s.
+		return hurwitz_zeta_taylor(s, a)
+	}
+	return hurwitz_zeta_scaled(s, a, n) * math.Pow(a, -s)
+}
+
+// HurwitzZetaPrime returns the derivative of the Hurwitz zeta function with respect to s,
+// defined by
+//
+//	                                                   ∞
+//	HurwitzZetaPrime(s, a) = d/ds HurwitzZeta(s, a) = -∑ Log(k+a) / (k+a)**s
+//	                                                  k=0
+//
+// for s > 1 and a > 0, and by analytic continuation for all real s ≠ 1. In particular,
+// HurwitzZetaPrime(0, a) = Lgamma(a) - Log(2π)/2.
+//
+// See http://mathworld.wolfram.com/HurwitzZetaFunction.html for more information.
+func HurwitzZetaPrime(s, a float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(s) || math.IsNaN(a) || math.IsInf(s, -1) || math.IsInf(a, 1) || a <= 0:
+		return math.NaN()
+	case s == 1:
+		return math.Inf(-1)
+	case math.IsInf(s, 1):
+		if a < 1 {
+			return math.Inf(1)
+		}
+		return 0
+	}
+
+	const tol = 1e-17
+
+	n := hurwitz_zeta_n(s, a)
+	if s < 0 && n > 0 {
+		// Avoid the cancellation in the Euler-Maclaurin summation formula for negative s.
+		return hurwitz_zeta_prime_taylor(s, a)
+	}
+
+	// Differentiate the Euler-Maclaurin summation formula of hurwitz_zeta_scaled term by term.
+	res := 0.0
+	for k := 0.0; k < n; k++ {
+		res -= math.Log(a+k) * math.Pow(a+k, -s)
+	}
+	x := a + n
+	lx := math.Log(x)
+	xs := math.Pow(x, -s)
+	res -= xs * x * (lx + 1/(s-1)) / (s - 1)
+	res -= lx * xs / 2
+
+	// p = s(s+1)...(s+2k-2) and dp = d/ds p, with tmp = x**(-s-2k+1).
+	p, dp := s, 1.0
+	tmp := xs / x
+	for k, b := range bernoulli_coefficients {
+		term := b * tmp * (dp - lx*p)
+		res += term
+		if math.Abs(term) < tol*math.Abs(res) {
+			break
+		}
+		fk := float64(2*k + 2)
+		q := (s + fk - 1) * (s + fk)
+		dp = dp*q + p*(2*s+2*fk-1)
+		p *= q
+		tmp /= x * x
+	}
+	return res
+}
+
+// hurwitz_zeta_scaled returns a**s HurwitzZeta(s, a) for s ≠ 1 and a > 0 using the
+// Euler-Maclaurin summation formula
+//
+//	                    N-1
+//	HurwitzZeta(s, a) =  ∑ (k+a)**(-s) + x**(1-s) / (s-1) + x**(-s) / 2 + ∑ T[k]
+//	                    k=0                                               k
+//
+// where x = a+N, T[k] = B(2k) / (2k)! s(s+1)...(s+2k-2) x**(-s-2k+1) and B(n) is the nth
+// Bernoulli number. Scaling by a**s avoids overflow for small a and large s.
+func hurwitz_zeta_scaled(s, a, n float64) float64 {
+	const tol = 1e-17
+
+	res := 0.0
+	for k := 0.0; k < n; k++ {
+		res += math.Exp(-s * math.Log1p(k/a))
+	}
+	x := a + n
+	xs := math.Exp(-s * math.Log1p(n/a))
+	res += xs*x/(s-1) + xs/2
+
+	// tmp = s(s+1)...(s+2k-2) (x/a)**(-s) x**(-2k+1).
+	tmp := s * xs / x
+	for k, b := range bernoulli_coefficients {
+		term := b * tmp
+		res += term
+		if math.Abs(term) < tol*math.Abs(res) {
+			break
+		}
+		fk := float64(2*k + 2)
+		tmp *= (s + fk - 1) * (s + fk) / (x * x)
+	}
+	return res
+}
+
+// hurwitz_zeta_complex returns HurwitzZeta(s, a) for real s ≠ 1 and complex a with Re(a) ≥ 0,
+// a ≠ 0, using the Euler-Maclaurin summation formula of hurwitz_zeta_scaled with the principal
+// branch of (k+a)**(-s). It also returns the sum of the absolute values of the terms, which
+// bounds the rounding error of the result when multiplied by the machine epsilon.
+func hurwitz_zeta_complex(s float64, a complex128) (complex128, float64) {
+	const tol = 1e-17
+
+	n := hurwitz_zeta_n(s, real(a))
+	cs := complex(-s, 0)
+
+	var res complex128
+	abs := 0.0
+	for k := 0.0; k < n; k++ {
+		term := cmplx.Pow(a+complex(k, 0), cs)
+		res += term
+		abs += cmplx.Abs(term)
+	}
+	x := a + complex(n, 0)
+	xs := cmplx.Pow(x, cs)
+	res += xs*x/complex(s-1, 0) + xs/2
+	abs += cmplx.Abs(xs*x/complex(s-1, 0)) + cmplx.Abs(xs/2)
+
+	// tmp = s(s+1)...(s+2k-2) x**(-s-2k+1).
+	tmp := complex(s, 0) * xs / x
+	for k, b := range bernoulli_coefficients {
+		term := complex(b, 0) * tmp
+		res += term
+		abs += cmplx.Abs(term)
+		if cmplx.Abs(term) < tol*cmplx.Abs(res) {
+			break
+		}
+		fk := float64(2*k + 2)
+		tmp *= complex((s+fk-1)*(s+fk), 0) / (x * x)
+	}
+	return res, abs
+}
+
+// hurwitz_zeta_taylor returns HurwitzZeta(s, a) for s < 0 and small a > 0, using the recurrence
+// relation HurwitzZeta(s, a) = HurwitzZeta(s, a+1) + a**(-s) to bring a into (1/2, 3/2], and
+// then the Taylor series about a = 1
+//
+//	                      ∞
+//	HurwitzZeta(s, 1+x) = ∑ (-x)**k Poch(s, k) / k! Zeta(s+k)
+//	                     k=0
+//
+// which converges for |x| < 1. See 25.11.10, Digital Library of Mathematical Functions
+// (https://dlmf.nist.gov/25.11).
+func hurwitz_zeta_taylor(s, a float64) float64 {
+	const (
+		maxiter = 200
+		tol     = 1e-17
+	)
+
+	res := 0.0
+	for a > 1.5 {
+		a--
+		res -= math.Pow(a, -s)
+	}
+	if a <= 0.5 {
+		res += math.Pow(a, -s)
+		a++
+	}
+
+	x := a - 1
+	sum := 0.0
+	c := 1.0 // (-x)**k Poch(s, k) / k!
+	cprev := 0.0
+	for k := 0; k < maxiter; k++ {
+		fk := float64(k)
+		var term float64
+		if s+fk == 1 {
+			// For integer s, Poch(s, k) Zeta(s+k) -> Poch(s, k-1) at the pole of Zeta, and the
+			// terms vanish for larger k.
+			term = -x * cprev / fk
+		} else {
+			term = c * Zeta(s+fk)
+		}
+		sum += term
+		if s+fk > 1 && math.Abs(term) < tol*math.Abs(sum) {
+			break
+		}
+		cprev = c
+		c *= -x * (s + fk) / (fk + 1)
+	}
+	return res + sum
+}
+
+// hurwitz_zeta_prime_taylor returns HurwitzZetaPrime(s, a) for s < 0 and small a > 0, by
+// differentiating the recurrence relation and Taylor series of hurwitz_zeta_taylor term by term.
+func hurwitz_zeta_prime_taylor(s, a float64) float64 {
+	const (
+		maxiter = 200
+		tol     = 1e-17
+	)
+
+	res := 0.0
+	for a > 1.5 {
+		a--
+		res += math.Log(a) * math.Pow(a, -s)
+	}
+	if a <= 0.5 {
+		res -= math.Log(a) * math.Pow(a, -s)
+		a++
+	}
+
+	x := a - 1
+	sum := 0.0
+	c, dc := 1.0, 0.0 // (-x)**k Poch(s, k) / k! and its derivative with respect to s
+	cprev, dcprev := 0.0, 0.0
+	for k := 0; k < maxiter; k++ {
+		fk := float64(k)
+		var term float64
+		if s+fk == 1 {
+			// For integer s, Poch(s, k) Zeta(s+k) = Poch(s, k-1) (1 + EulerGamma u + O(u**2)),
+			// where u = s+k-1 -> 0.
+			term = -x * (dcprev + EulerGamma*cprev) / fk
+		} else {
+			term = dc * Zeta(s+fk)
+			if c != 0 {
+				term += c * zeta_prime(s+fk)
+			}
+		}
+		sum += term
+		if s+fk > 1 && math.Abs(term) < tol*math.Abs(sum) {
+			break
+		}
+		cprev, dcprev = c, dc
+		c, dc = -x*(s+fk)/(fk+1)*c, -x*((s+fk)*dc+c)/(fk+1)
+	}
+	return res + sum
+}
+
+// zeta_prime returns the derivative of the Riemann zeta function, HurwitzZetaPrime(s, 1),
+// using the derivative of the functional equation
+//
+//	Zeta(s) = 2**s π**(s-1) Sin(πs/2) Gamma(1-s) Zeta(1-s)
+//
+// for s < 0.
+func zeta_prime(s float64) float64 {
+	if s >= 0 {
+		return HurwitzZetaPrime(s, 1)
+	}
+	lg, sg := math.Lgamma(1 - s)
+	f := float64(sg) * math.Exp(s*math.Ln2+(s-1)*math.Log(math.Pi)+lg)
+	z := Zeta(1 - s)
+	l := math.Log(2*math.Pi) - Digamma(1-s)
+	return f * (sinPi(s/2)*(l*z-HurwitzZetaPrime(1-s, 1)) + math.Pi/2*cosPi(s/2)*z)
+}
+
+// hurwitz_zeta_n returns the number of terms N summed directly in the Euler-Maclaurin
+// summation formula, chosen such that the ratio of successive correction terms,
+// approximately ((s+2k) / 2π(a+N))**2, is small for 2k ≤ 40. For negative s, a+N is kept
+// as small as possible to limit the cancellation between the terms.
+func hurwitz_zeta_n(s, a float64) float64 {
+	xmin := (s + 40) / math.Pi
+	if s < 0 {
+		xmin = math.Max(7, -s/math.Pi)
+	}
+	return math.Max(0, math.Ceil(xmin-a))
+}
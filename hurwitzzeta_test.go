@@ -0,0 +1,91 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestHurwitzZeta(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 1, nan},
+		{2, nan, nan},
+		{2, 0, nan},
+		{2, -1, nan},
+		{-inf, 1, nan},
+		{1, 2, inf},
+		{inf, 0.5, inf},
+		{inf, 1, 1},
+		{inf, 2, 0},
+		{2, inf, 0},
+		{2, 1, 1.6449340668482264},
+		{2, 0.5, 4.934802200544679},
+		{3, 0.25, 64.66386996876847},
+		{1.5, 10, 0.6486616319415704},
+		{0.5, 1, -1.4603545088095868},
+		{-0.5, 2, -1.2078862249773545},
+		{-3, 1, 0.008333333333333333},
+		{-3, 0.3, -0.002691666666666666},
+		{-3.5, 0.3, 0.0024573282377220885},
+		{-10.5, 1.5, -0.011828959663787822},
+		{-20.5, 0.5, 108.21740208236761},
+		{7, 0.001, 9.999999999999999e+20},
+		{50, 2, 8.881784210930816e-16},
+		{1.0001, 1, 10000.577222947539},
+		{0.9999, 3, -10000.922826276394},
+		{21, 10, 1.1620416915380834e-21},
+		{0, 0.7, -0.19999999999999996},
+		{0.2, 0.01, 1.7668001263209614},
+		{-15.3, 7.5, -2961560059907.7563},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := HurwitzZeta(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestHurwitzZetaPrime(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 1, nan},
+		{2, -1, nan},
+		{2, inf, nan},
+		{1, 2, -inf},
+		{inf, 0.5, inf},
+		{inf, 2, 0},
+		{0, 0.7, -0.6580712866730062},
+		{2, 1, -0.9375482543158438},
+		{-1, 1, -0.16542114370045094},
+		{-1, 0.3, 0.09581589025010605},
+		{-2, 2.5, 0.7618460408971784},
+		{-3, 0.5, -0.003984225999969237},
+		{0.5, 1, -3.9226461392091516},
+		{3, 0.25, 88.43889946091457},
+		{-0.5, 4, 2.5222561056612913},
+		{1.5, 0.01, 4601.2305426586},
+		{20, 1.5, -0.00012194506988443217},
+		{-5.5, 0.8, -0.0051038945182169115},
+		{0.999, 2, -999999.9271744634},
+		{-12.5, 3.3, 27688.852350913337},
+		{-1, 20, 469.5221131357048},
+		{-0.3, 0.05, 0.6854798884619132},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := HurwitzZetaPrime(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package special
 
-import "math"
+import (
+	"math"
+	"math/big"
+)
 
 // HypPFQ returns the type-(p,q) generalised hypergeometric function, defined by
 //
@@ -19,8 +22,108 @@ import "math"
 //
 // See http://mathworld.wolfram.com/HypergeometricFunction.html for more information.
 func HypPFQ(a, b []float64, x float64) float64 {
+	res, _ := hyp_pfq(a, b, x, false)
+	return res
+}
+
+// HypPFQErr returns HypPFQ(a, b, x) together with an estimate of its absolute error, which
+// accounts for rounding errors, cancellation between the terms of the series and its
+// truncation. A result whose error is comparable to its magnitude is unreliable.
+//
+// Unlike HypPFQ, HypPFQErr also sums the divergent series with p = q+1 and x < -1, and with
+// p > q+1 and x < 0, using the Levin u-transform. These give the analytic continuation of pFq
+// and the Borel sum of the series respectively, e.g.
+//
+//	2F0(a, b; ; x) = (-1/x)**a HypU(a, a-b+1, -1/x)
+func HypPFQErr(a, b []float64, x float64) (float64, float64) {
+	return hyp_pfq(a, b, x, true)
+}
+
+// HypPFQRegularized returns the regularised generalised hypergeometric function, defined by
+//
+//	                                           q-1
+//	HypPFQRegularized(a, b, x) = pFq(a; b; x) / ∏ Gamma(b[j])
+//	                                           j=0
+//
+// which, unlike pFq, is finite when b contains non-positive integers. If K is the greatest
+// value of 1-b[j] for the non-positive integers b[j], the first K terms of the series vanish
+// and
+//
+//	                              x**K p-1              q-1
+//	HypPFQRegularized(a, b, x) = ------ ∏ Poch(a[i], K) / ∏ Gamma(b[j]+K) pFq(a+K; b'; x)
+//	                               K!  i=0              j=0
+//
+// where b' is b+K with one of the elements equal to 1 replaced by K+1.
+//
+// See 16.2.5, Digital Library of Mathematical Functions (https://dlmf.nist.gov/16.2).
+func HypPFQRegularized(a, b []float64, x float64) float64 {
+	res, _ := hyp_pfq_regularized(a, b, x, false)
+	return res
+}
+
+// hyp_pfq_regularized returns HypPFQRegularized(a, b, x) and an estimate of its absolute error.
+// If sumdiv is true, the divergent series are summed as in HypPFQErr.
+func hyp_pfq_regularized(a, b []float64, x float64, sumdiv bool) (float64, float64) {
+	n := 0.0
+	for _, bj := range b {
+		if isNonPosInt(bj) {
+			n = math.Max(n, 1-bj)
+		}
+	}
+	if n == 0 {
+		res, err := hyp_pfq(a, b, x, sumdiv)
+		g, eg := hyp_gamma_ratio([]float64{}, b)
+		return res * g, math.Abs(g)*err + eg*math.Abs(res)
+	}
+
+	// The logarithm and sign of the first non-zero term.
+	lt, st := 0.0, 1
+	for k := 0.0; k < n; k++ {
+		for _, ai := range a {
+			switch {
+			case math.IsNaN(ai):
+				return math.NaN(), math.NaN()
+			case ai+k == 0:
+				// The series terminates before the first non-zero term.
+				return 0, 0
+			case ai+k < 0:
+				st = -st
+			}
+			lt += math.Log(math.Abs(ai + k))
+		}
+		lt += math.Log(math.Abs(x)) - math.Log(k+1)
+		if x < 0 {
+			st = -st
+		}
+	}
+
+	aa := make([]float64, len(a))
+	for i, ai := range a {
+		aa[i] = ai + n
+	}
+	bb := make([]float64, len(b))
+	for j, bj := range b {
+		bb[j] = bj + n
+	}
+	lg, sg := LgammaRatio([]float64{}, bb)
+	for j := range bb {
+		if bb[j] == 1 {
+			bb[j] = n + 1
+			break
+		}
+	}
+	res, err := hyp_pfq(aa, bb, x, sumdiv)
+	return hyp2f1_scale(res, err, float64(st*sg)*math.Exp(lt+lg), lt+lg)
+}
+
+// hyp_eps is the machine epsilon, used in the error estimates of HypPFQErr.
+const hyp_eps = 0x1p-52
+
+// hyp_pfq returns pFq(a; b; x) and an estimate of its absolute error. If sumdiv is true, the
+// divergent series that hyp_pfq_levin can sum are summed, rather than returning NaN.
+func hyp_pfq(a, b []float64, x float64, sumdiv bool) (float64, float64) {
 	if x == 0 {
-		return 1
+		return 1, 0
 	}
 
 	// Remove values common to a and b.
@@ -72,7 +175,7 @@ func HypPFQ(a, b []float64, x float64) float64 {
 	// Case 2. Reduce pFq to pFq-1 and examine whether x is infinite.
 	for nbinf > 0 {
 		if nbinf > 1 || !math.IsInf(x, 0) {
-			return 1
+			return 1, 0
 		}
 		binfi := binf[0]
 		nbinf--
@@ -83,17 +186,19 @@ func HypPFQ(a, b []float64, x float64) float64 {
 
 	// Case 3. The sum is divergent.
 	if nainf > 0 {
-		return math.NaN()
+		return math.NaN(), math.NaN()
 	}
 
 	// Sum reduces to ∑ x**k / k! = Exp(x) when na = nb = 0.
 	if na == 0 && nb == 0 {
-		return math.Exp(x)
+		res := math.Exp(x)
+		return res, hyp_eps * math.Abs(res)
 	}
 
-	// Sum reduces to (1 - x)**(-a[0]) when na = 0 & nb = 1.
+	// Sum reduces to (1 - x)**(-a[0]) when na = 0 & nb = 1, taking the principal value for x > 1.
 	if na == 1 && nb == 0 {
-		return math.Pow(1-x, -a[0])
+		res := hyp2f1_pow(x, -a[0])
+		return res, hyp_eps * math.Abs(res) * (1 + math.Abs(a[0]*math.Log(math.Abs(1-x))))
 	}
 
 	// Get the greatest non-positive element from from a and b, or
@@ -134,7 +239,7 @@ func HypPFQ(a, b []float64, x float64) float64 {
 
 	if bmin <= 0 {
 		if bmin == math.Trunc(bmin) && (amin != math.Trunc(amin) || bmin > amin || amin > 0) {
-			return math.NaN()
+			return math.NaN(), math.NaN()
 		}
 	}
 
@@ -145,40 +250,224 @@ func HypPFQ(a, b []float64, x float64) float64 {
 
 	// Sum diverges when na > nb + 1 unless it has been truncated.
 	if na > nb+1 && !istrunc {
-		return math.NaN()
+		if sumdiv && x < 0 {
+			return hyp_pfq_levin(a, b, x)
+		}
+		return math.NaN(), math.NaN()
 	}
 
-	// Sum diverges when na = nb + 1  and |x| > 1 unless it has been truncated.
-	if na == nb+1 && math.Abs(x) > 1 && !istrunc {
-		return math.NaN()
+	// Sum diverges when na = nb + 1  and |x| > 1 unless it has been truncated. The exception
+	// is 2F1, which is continued analytically by hyp2f1. For x = 1, the sum converges only if
+	// the parameters satisfy ∑ b[j] - ∑ a[i] > 0.
+	if na == nb+1 && nb != 1 && !istrunc {
+		sab := 0.0
+		for i := 0; i < nb; i++ {
+			sab += b[i] - a[i]
+		}
+		sab -= a[na-1]
+		switch {
+		case x < -1 && sumdiv:
+			return hyp_pfq_levin(a, b, x)
+		case math.Abs(x) > 1 || x == 1 && sab <= 0:
+			return math.NaN(), math.NaN()
+		case math.Abs(x) == 1:
+			// The terms decrease like a power of k, so the series converges too slowly to be
+			// summed directly.
+			return hyp_pfq_levin(a, b, x)
+		}
 	}
 
 	switch {
 	case na == 1 && nb == 1:
 		return hyp1f1(a[0], b[0], x, numt, tol, istrunc)
 	case na == 2 && nb == 1:
-		return hyp2f1(a[0], a[1], b[0], x, numt, tol)
-	default:
-		t := 1.0
-		res := t
-		for k := 1; k <= numt && math.Abs(t/res) > tol; k++ {
+		return hyp2f1(a[0], a[1], b[0], x, numt, tol, istrunc)
+	}
+	return hyp_pfq_sum(a, b, x, numt, tol)
+}
+
+// hyp_pfq_sum returns the pFq(a; b; x) series, summed up to the term in x**numt or until the
+// terms are smaller than tol relative to the sum, and an estimate of its absolute error. If
+// the terms of a series with p = q+1 have not become small enough after maxiter terms, it is
+// summed by hyp_pfq_levin instead.
+func hyp_pfq_sum(a, b []float64, x float64, numt int, tol float64) (float64, float64) {
+	const maxiter = 1000000
+
+	na := len(a)
+	nb := len(b)
+	t := 1.0
+	res := t
+	abs := t // ∑ |t|
+	k := 1
+	for ; k <= numt && math.Abs(t/res) > tol; k++ {
+		if na == nb+1 && k > maxiter {
+			return hyp_pfq_levin(a, b, x)
+		}
+		kk := float64(k)
+		t *= x / kk
+		kk--
+		for i := 0; i < na; i++ {
+			t *= kk + a[i]
+		}
+		for i := 0; i < nb; i++ {
+			t /= kk + b[i]
+		}
+		res += t
+		abs += math.Abs(t)
+	}
+
+	// The remaining terms are bounded by a geometric series with ratio |x| when p = q+1.
+	tail := 0.0
+	if k <= numt {
+		tail = math.Abs(t)
+		if na == nb+1 {
+			tail /= 1 - math.Abs(x)
+		}
+	}
+	return hyp_pfq_resum(a, b, x, numt, k, 1, res, abs, tail)
+}
+
+// hyp_pfq_resum returns the sum res of the first n terms of the pFq(a; b; x) series multiplied
+// by scale, where abs is the sum of the absolute values of the terms and tail bounds the terms
+// that were not summed, and an estimate of its absolute error. If more than a few digits of
+// the sum have been lost to cancellation between the terms, the series is summed again using
+// hyp_pfq_sum_big with enough extra precision to make up for the cancellation.
+func hyp_pfq_resum(a, b []float64, x float64, numt, n int, scale, res, abs, tail float64) (float64, float64) {
+	const (
+		maxcancel = 64
+		maxprec   = 4096
+	)
+
+	// The kth term, computed by recurrence, has a relative rounding error that grows like
+	// Sqrt(k) hyp_eps.
+	if !(abs > maxcancel*math.Abs(res)) || math.IsInf(abs, 0) {
+		return res, 4*(1+math.Sqrt(float64(n)))*hyp_eps*abs + tail
+	}
+
+	// The precision needed depends on the sum itself, so increase it until it is sufficient.
+	prec := uint(64)
+	for {
+		need := uint(maxprec)
+		if res != 0 {
+			need = uint(math.Min(maxprec, 64+math.Ceil(math.Log2(abs/math.Abs(res)))))
+		}
+		if need <= prec {
+			break
+		}
+		prec = need
+		res = scale * hyp_pfq_sum_big(a, b, x, numt, prec)
+	}
+	// hyp_pfq_sum_big sums the terms until they are negligible, so tail no longer applies.
+	return res, 2 * hyp_eps * math.Abs(res)
+}
+
+// hyp_pfq_sum_big returns the pFq(a; b; x) series, summed up to the term in x**numt using
+// prec bits of precision, until the terms are smaller than the sum by a factor of 2**(-prec).
+func hyp_pfq_sum_big(a, b []float64, x float64, numt int, prec uint) float64 {
+	bx := new(big.Float).SetPrec(prec).SetFloat64(x)
+	t := new(big.Float).SetPrec(prec).SetFloat64(1)
+	res := new(big.Float).SetPrec(prec).SetFloat64(1)
+	p := new(big.Float).SetPrec(prec)
+	for k := 1; k <= numt; k++ {
+		kk := float64(k - 1)
+		t.Mul(t, bx)
+		t.Quo(t, p.SetFloat64(float64(k)))
+		for _, ai := range a {
+			t.Mul(t, p.Add(p.SetFloat64(ai), big.NewFloat(kk)))
+		}
+		for _, bi := range b {
+			t.Quo(t, p.Add(p.SetFloat64(bi), big.NewFloat(kk)))
+		}
+		res.Add(res, t)
+		if t.Sign() == 0 || res.Sign() != 0 && res.MantExp(nil)-t.MantExp(nil) > int(prec) {
+			break
+		}
+	}
+	f, _ := res.Float64()
+	return f
+}
+
+// hyp_pfq_levin returns the sum of the pFq(a; b; x) series, and an estimate of its absolute
+// error, using the Levin u-transform of its partial sums S[j],
+//
+//	       k                                      k
+//	T[k] = ∑ (-1)**j C(k, j) c[j] S[j] / ω[j]  /  ∑ (-1)**j C(k, j) c[j] / ω[j]
+//	      j=0                                    j=0
+//
+// where c[j] = ((j+1)/(k+1))**(k-1), ω[j] = (j+1) t[j] and t[j] is the term in x**j. The
+// transform accelerates series that converge slowly and sums many divergent series, such as
+// those with p = q+1 and x < -1, and with p > q+1 and x < 0. The T[k] with the smallest
+// differences from T[k-1] and T[k+1] is returned, and the differences are used to estimate
+// its error.
+func hyp_pfq_levin(a, b []float64, x float64) (float64, float64) {
+	const maxterms = 40
+
+	// The terms and partial sums of the series.
+	ts := make([]float64, 0, maxterms)
+	ss := make([]float64, 0, maxterms)
+	t := 1.0
+	sum := t
+	for k := 0; k < maxterms; k++ {
+		if k > 0 {
 			kk := float64(k)
 			t *= x / kk
 			kk--
-			for i := 0; i < na; i++ {
-				t *= kk + a[i]
+			for _, ai := range a {
+				t *= kk + ai
 			}
-			for i := 0; i < nb; i++ {
-				t /= kk + b[i]
+			for _, bi := range b {
+				t /= kk + bi
 			}
-			res += t
+			sum += t
+		}
+		if t == 0 || math.IsInf(t, 0) || math.IsNaN(t) {
+			break
+		}
+		ts = append(ts, t)
+		ss = append(ss, sum)
+	}
+
+	// The transforms T[k] and estimates of their rounding errors.
+	n := len(ts)
+	tr := make([]float64, n)
+	rnd := make([]float64, n)
+	tr[0] = ss[0]
+	for k := 1; k < n; k++ {
+		fk := float64(k)
+		num, den, abs := 0.0, 0.0, 0.0
+		c := 1.0 // (-1)**j C(k, j)
+		for j := 0; j <= k; j++ {
+			fj := float64(j)
+			w := c * math.Pow((fj+1)/(fk+1), fk-1) / ((fj + 1) * ts[j])
+			num += w * ss[j]
+			den += w
+			abs += math.Abs(w * ss[j])
+			c *= -(fk - fj) / (fj + 1)
 		}
-		return res
+		tr[k] = num / den
+		rnd[k] = hyp_eps * abs / math.Abs(den)
 	}
+
+	// Choose the T[k] that differs least from its neighbours.
+	diff := func(k int) float64 {
+		if k >= n {
+			return 0
+		}
+		return math.Abs(tr[k]-tr[k-1]) + rnd[k]
+	}
+	res := tr[0]
+	err := math.Inf(1)
+	for k := 1; k < n; k++ {
+		if e := 2 * math.Max(diff(k), diff(k+1)); e < err {
+			res, err = tr[k], e
+		}
+	}
+	return res, err + 2*hyp_eps*math.Abs(res)
 }
 
-// hyp1f1 returns the 1F1(a; b; x) series, and assumes that b is not a non-positive integer.
-func hyp1f1(a, b, x float64, numt int, tol float64, istrunc bool) float64 {
+// hyp1f1 returns the 1F1(a; b; x) series, and assumes that b is not a non-positive integer. It
+// also returns an estimate of the absolute error of the sum.
+func hyp1f1(a, b, x float64, numt int, tol float64, istrunc bool) (float64, float64) {
 	t := 1.0
 	n := int(math.Abs(x/700) + 1)
 	count := n
@@ -195,8 +484,11 @@ func hyp1f1(a, b, x float64, numt int, tol float64, istrunc bool) float64 {
 		}
 	}
 
+	scale := t
 	res := t
-	for k := 1; k <= numt && math.Abs(t/res) > tol; k++ {
+	abs := t
+	k := 1
+	for ; k <= numt && math.Abs(t/res) > tol; k++ {
 		kk := float64(k)
 		t *= x / kk
 		kk--
@@ -204,23 +496,86 @@ func hyp1f1(a, b, x float64, numt int, tol float64, istrunc bool) float64 {
 		if res > 1e200 && count < n {
 			t *= scalef
 			res *= scalef
+			abs *= scalef
 			count++
 		}
 		res += t
+		abs += math.Abs(t)
 	}
 
-	for count < n {
-		res *= scalef
-		count++
+	tail := 0.0
+	if k <= numt {
+		tail = math.Abs(t)
 	}
-	return res
+	if n > 1 {
+		for count < n {
+			res *= scalef
+			abs *= scalef
+			tail *= scalef
+			count++
+		}
+		return res, 4*(1+math.Sqrt(float64(k)))*hyp_eps*abs + tail
+	}
+	return hyp_pfq_resum([]float64{a}, []float64{b}, x, numt, k, scale, res, abs, tail)
 }
 
-// hyp2f1 returns the 2F1(a, b; c; x) series, and assumes that c is not a non-positive integer.
-func hyp2f1(a, b, c, x float64, numt int, tol float64) float64 {
+// Hyp2F1 returns the Gauss hypergeometric function, defined by
+//
+//	                     ∞
+//	Hyp2F1(a, b, c, x) = ∑ (a)_k (b)_k / (c)_k x**k / k!
+//	                    k=0
+//
+// for |x| < 1, and by analytic continuation for all real x. For x > 1, which lies on the
+// branch cut, Hyp2F1 returns the principal value, i.e. the mean of the limits from above
+// and below the cut. Hyp2F1(a, b, c, x) = HypPFQ([]float64{a, b}, []float64{c}, x).
+//
+// See http://mathworld.wolfram.com/HypergeometricFunction.html for more information.
+func Hyp2F1(a, b, c, x float64) float64 {
+	return HypPFQ([]float64{a, b}, []float64{c}, x)
+}
+
+// hyp2f1 returns 2F1(a, b; c; x), and assumes that c is not a non-positive integer. Unless the
+// series is truncated, the linear transformations of 15.3.3-15.3.7, p559, Abramowitz & Stegun,
+// are used to map x into [-1/2, 3/4], and for x > 1 the principal value is returned. It also
+// returns an estimate of the absolute error of the result.
+func hyp2f1(a, b, c, x float64, numt int, tol float64, istrunc bool) (float64, float64) {
+	if !istrunc {
+		if n, ok := hyp2f1_terms(a, b); ok {
+			return hyp2f1(a, b, c, x, n, tol, true)
+		}
+
+		s := c - a - b
+		switch {
+		case x == 1 && s <= 0:
+			sg := GammaSign(c) * GammaSign(a) * GammaSign(b)
+			if s < 0 {
+				sg *= GammaSign(-s)
+			}
+			return math.Copysign(math.Inf(1), float64(sg)), 0
+		case x == 1:
+			// Gauss's formula, below.
+		case isNonPosInt(c-a) || isNonPosInt(c-b):
+			// Euler's transformation, 2F1(a, b; c; x) = (1-x)**(c-a-b) 2F1(c-a, c-b; c; x),
+			// gives a polynomial.
+			n, _ := hyp2f1_terms(c-a, c-b)
+			res, err := hyp2f1(c-a, c-b, c, x, n, tol, true)
+			return hyp2f1_scale(res, err, hyp2f1_pow(x, s), s*math.Log(math.Abs(1-x)))
+		case x < -1:
+			// Transform to x -> x/(x-1) in (1/2, 1). See 15.3.4, p559, Abramowitz & Stegun.
+			res, err := hyp2f1(a, c-b, c, x/(x-1), numt, tol, false)
+			return hyp2f1_scale(res, err, math.Pow(1-x, -a), a*math.Log(1-x))
+		case x > 0.75 && x < 1.5:
+			return hyp2f1_one_minus_x(a, b, c, x, tol)
+		case x >= 1.5:
+			return hyp2f1_inverse_x(a, b, c, x, tol)
+		}
+	}
+
 	// For x < 0, transform to x -> x/(x-1) > 0. See 15.3.4, p559, Ambramowitz & Stegun.
+	// A truncated series is summed directly, since the transformation need not preserve
+	// the parameter that truncates it.
 	scale := 1.0
-	if x < 0 {
+	if x < 0 && !istrunc {
 		if b > a {
 			b = c - b
 		} else {
@@ -232,21 +587,360 @@ func hyp2f1(a, b, c, x float64, numt int, tol float64) float64 {
 
 	// Gauss's formula for x = 1.
 	if x == 1 && c-a-b > 0 {
-		lgc, sgc := math.Lgamma(c)
-		lgcab, sgcab := math.Lgamma(c - a - b)
-		lgca, sgca := math.Lgamma(c - a)
-		lgcb, sgcb := math.Lgamma(c - b)
-		return scale * float64(sgc*sgcab*sgca*sgcb) * math.Exp(lgc+lgcab-lgca-lgcb)
+		return hyp_gamma_ratio([]float64{c, c - a - b}, []float64{c - a, c - b})
 	}
 
 	t := 1.0
 	res := t
-	for k := 1; k <= numt && math.Abs(t/res) > tol; k++ {
+	abs := t
+	k := 1
+	for ; k <= numt && math.Abs(t/res) > tol; k++ {
 		kk := float64(k)
 		t *= x / kk
 		kk--
 		t *= (kk + a) * (kk + b) / (kk + c)
 		res += t
+		abs += math.Abs(t)
+	}
+	tail := 0.0
+	if k <= numt {
+		tail = math.Abs(t) / (1 - math.Abs(x))
+	}
+	return hyp_pfq_resum([]float64{a, b}, []float64{c}, x, numt, k, scale, scale*res, scale*abs, scale*tail)
+}
+
+// hyp2f1_terms returns the number of non-zero terms after the first in the 2F1 series and
+// true if a or b is a non-positive integer, so that the series is a polynomial.
+func hyp2f1_terms(a, b float64) (int, bool) {
+	switch {
+	case isNonPosInt(a) && isNonPosInt(b):
+		return -int(math.Max(a, b)), true
+	case isNonPosInt(a):
+		return -int(a), true
+	case isNonPosInt(b):
+		return -int(b), true
+	}
+	return 0, false
+}
+
+// hyp2f1_pow returns the principal value of (1-x)**s, i.e. its real part for x > 1.
+func hyp2f1_pow(x, s float64) float64 {
+	if x > 1 {
+		return cosPi(s) * math.Pow(x-1, s)
+	}
+	return math.Pow(1-x, s)
+}
+
+// hyp2f1_scale returns f*res and its absolute error, where err is the absolute error of res
+// and f = Exp(l) is computed with a relative error of about hyp_eps (1 + |l|).
+func hyp2f1_scale(res, err, f, l float64) (float64, float64) {
+	res *= f
+	return res, math.Abs(f)*err + hyp_eps*(1+math.Abs(l))*math.Abs(res)
+}
+
+// hyp_gamma_ratio returns GammaRatio(x, y) and an estimate of its absolute error, assuming
+// that the Lgamma of each element is computed with an absolute error of about 2 hyp_eps.
+func hyp_gamma_ratio(x, y []float64) (float64, float64) {
+	res := GammaRatio(x, y)
+	e := 1.0
+	for _, xi := range append(x[:len(x):len(x)], y...) {
+		lg, _ := math.Lgamma(xi)
+		if !math.IsInf(lg, 0) {
+			e += 1 + math.Abs(lg)
+		}
+	}
+	return res, 2 * hyp_eps * e * math.Abs(res)
+}
+
+// hyp2f1_one_minus_x returns 2F1(a, b; c; x) for 3/4 < x < 3/2 using the transformation to
+// w = 1-x,
+//
+//	2F1(a, b; c; x) = Gamma(c) Gamma(s) / (Gamma(c-a) Gamma(c-b)) 2F1(a, b; 1-s; w)
+//	                + Gamma(c) Gamma(-s) / (Gamma(a) Gamma(b)) w**s 2F1(c-a, c-b; 1+s; w)
+//
+// where s = c-a-b is not an integer. This loses accuracy as s approaches an integer m, so for
+// s = m the logarithmic series of hyp2f1_log is used instead, and for 0 < |s-m| < 0.01 the
+// series of hyp2f1_near, which pairs the cancelling terms. See 15.3.6, p559, Abramowitz &
+// Stegun.
+func hyp2f1_one_minus_x(a, b, c, x, tol float64) (float64, float64) {
+	const stol = 0.01
+
+	w := 1 - x
+	s := c - a - b
+	if m := math.Round(s); s == m {
+		if m < 0 {
+			// Euler's transformation, 2F1(a, b; c; x) = w**s 2F1(c-a, c-b; c; x).
+			res, err := hyp2f1_log(c-a, c-b, -m, w, tol)
+			return hyp2f1_scale(res, err, math.Pow(w, m), m*math.Log(math.Abs(w)))
+		}
+		return hyp2f1_log(a, b, m, w, tol)
+	} else if math.Abs(s-m) < stol {
+		if m < 0 {
+			return hyp2f1_near(c-a, c-b, -m, m-s, w, tol, true)
+		}
+		return hyp2f1_near(a, b, m, s-m, w, tol, false)
+	}
+
+	f1, e1 := hyp2f1(a, b, 1-s, w, math.MaxInt32, tol, false)
+	f2, e2 := hyp2f1(c-a, c-b, 1+s, w, math.MaxInt32, tol, false)
+	g1, eg1 := hyp_gamma_ratio([]float64{c, s}, []float64{c - a, c - b})
+	g2, eg2 := hyp_gamma_ratio([]float64{c, -s}, []float64{a, b})
+	g2, eg2 = hyp2f1_scale(g2, eg2, hyp2f1_pow(x, s), s*math.Log(math.Abs(w)))
+	return g1*f1 + g2*f2, math.Abs(g1)*e1 + eg1*math.Abs(f1) + math.Abs(g2)*e2 + eg2*math.Abs(f2)
+}
+
+// hyp2f1_near returns 2F1(a, b; c; 1-w) for c = a+b+m+e, with integer m ≥ 0, 0 < |e| < 0.01
+// and |w| < 1/2. In the transformation of hyp2f1_one_minus_x, the terms of order w**k in the
+// first series with k ≥ m are paired with the terms of order w**(k+e) in the second, giving
+//
+//	                                                   m-1
+//	2F1(a, b; c; 1-w) = Gamma(m+e) Gamma(c) / G[m+e] ∑ (a)_k (b)_k / (k! (1-m-e)_k) w**k
+//	                                                   k=0
+//
+//	                                    ∞
+//	                  + (-1)**m w**m ∑ C[k] (1 - w**e R[k])
+//	                                   k=0
+//
+// where G[i] = Gamma(a+i) Gamma(b+i), C[k] = π / Sin(πe) Gamma(c) G[m+k] / (Gamma(a) Gamma(b)
+// G[m+e] Gamma(1-e+k) (k+m)!) w**k and R[k] = G[m+e+k] Gamma(1-e+k) (k+m)! / (G[m+k] k!
+// Gamma(m+1+e+k)). Since R[k] = 1 + O(e), its logarithm is accumulated with hyp_u_lgamma_diff
+// and Log1p, and 1 - w**e R[k] is found with Expm1. For w < 0, w**e is replaced by Cos(πe)
+// |w|**e, giving the principal value. If euler is true, the real part of w**(-m-e) times the
+// result is returned instead, which is 2F1(c-a, c-b; c; 1-w) by Euler's transformation. It also
+// returns an estimate of the absolute error.
+func hyp2f1_near(a, b, m, e, w, tol float64, euler bool) (float64, float64) {
+	const maxiter = 1000
+
+	c := a + b + m + e
+	lw := math.Log(math.Abs(w))
+	dr := 0.0 // 1 - rho, where rho is the real part of w**e / |w|**e.
+	if w < 0 {
+		dr = 2 * math.Pow(sinPi(e/2), 2)
+	}
+	rho := 1 - dr
+
+	// The finite sum.
+	fin, efin := 0.0, 0.0
+	if m > 0 {
+		sum := 0.0
+		abs := 0.0
+		t := 1.0 // (a)_k (b)_k / (k! (1-m-e)_k) w**k
+		for k := 0.0; k < m; k++ {
+			if k > 0 {
+				t *= (a + k - 1) * (b + k - 1) / (k * (k - m - e)) * w
+			}
+			sum += t
+			abs += math.Abs(t)
+		}
+		g, eg := hyp_gamma_ratio([]float64{m + e, c}, []float64{a + m + e, b + m + e})
+		fin = g * sum
+		efin = hyp_eps*math.Abs(g)*abs + eg*math.Abs(sum)
+	}
+
+	// The paired series, with l = Log|R[k]| + e Log|w| and sg = Sign(R[k]).
+	l, sg := e*lw, 1
+	for _, v := range [][3]float64{{a + m, e, 1}, {b + m, e, 1}, {1, -e, 1}, {m + 1, e, -1}} {
+		d, sd := hyp_u_lgamma_diff(v[0], v[1])
+		l += v[2] * d
+		sg *= sd
+	}
+	sum := 0.0
+	abs := 0.0
+	term := 0.0
+	t := 1.0 // C[k] / C[0]
+	for k := 0.0; k < maxiter; k++ {
+		var p float64 // 1 - w**e R[k], or w**e - R[k] if euler is true.
+		switch {
+		case sg < 0 && euler:
+			p = rho + math.Exp(l)
+		case sg < 0:
+			p = 1 + rho*math.Exp(l)
+		case euler:
+			p = -dr - math.Expm1(l)
+		default:
+			p = dr - rho*math.Expm1(l)
+		}
+		term = t * p
+		sum += term
+		abs += math.Abs(t) * (math.Abs(p) + math.Abs(l) + 1)
+		if math.Abs(term) < tol*math.Abs(sum) {
+			break
+		}
+		t *= (a + m + k) * (b + m + k) / ((k + 1 - e) * (k + m + 1)) * w
+		for _, v := range [][3]float64{{a + m + k, e, 1}, {b + m + k, e, 1}, {k + 1, -e, 1}, {k + m + 1, e, -1}} {
+			if r := 1 + v[1]/v[0]; r < 0 {
+				sg = -sg
+				l += v[2] * math.Log(-r)
+			} else {
+				l += v[2] * math.Log1p(v[1]/v[0])
+			}
+		}
+	}
+	g, eg := hyp_gamma_ratio([]float64{c, a + m, b + m}, []float64{a, b, a + m + e, b + m + e, 1 - e, m + 1})
+	g, eg = hyp2f1_scale(g, eg, math.Pi/sinPi(e)*math.Pow(-w, m), m*lw)
+	res := fin + g*sum
+	err := efin + math.Abs(g)*(hyp_eps*abs+math.Abs(term)) + eg*math.Abs(sum)
+	if euler {
+		// The terms of order w**k pick up the factor w**(-e), and those of order w**(k+e) do not.
+		return hyp2f1_scale(res-fin+rho*fin, err, math.Pow(w, -m)*math.Exp(-e*lw), (m+e)*lw)
+	}
+	return res, err
+}
+
+// hyp2f1_log returns 2F1(a, b; a+b+m; 1-w) for integer m ≥ 0 and |w| < 1, which is
+//
+//	                                                m-1
+//	2F1(a, b; a+b+m; 1-w) = Gamma(m) Gamma(c) / G[m] ∑ (a)_k (b)_k / (k! (1-m)_k) w**k
+//	                                                k=0
+//
+//	                                          ∞
+//	                - (-w)**m Gamma(c) / G[0] ∑ (a+m)_k (b+m)_k / (k! (k+m)!) w**k D[k]
+//	                                         k=0
+//
+// where c = a+b+m, G[i] = Gamma(a+i) Gamma(b+i) and D[k] = Log(w) - Digamma(k+1) -
+// Digamma(k+m+1) + Digamma(a+k+m) + Digamma(b+k+m). For w < 0, Log(w) is replaced by
+// Log(|w|), giving the principal value. It also returns an estimate of the absolute error.
+// See 15.3.10-15.3.11, p559, Abramowitz & Stegun.
+func hyp2f1_log(a, b, m, w, tol float64) (float64, float64) {
+	const maxiter = 1000
+
+	c := a + b + m
+
+	// The finite sum.
+	res, err := 0.0, 0.0
+	if m > 0 {
+		sum := 0.0
+		abs := 0.0
+		t := 1.0 // (a)_k (b)_k / (k! (1-m)_k) w**k
+		for k := 0.0; k < m; k++ {
+			if k > 0 {
+				t *= (a + k - 1) * (b + k - 1) / (k * (k - m)) * w
+			}
+			sum += t
+			abs += math.Abs(t)
+		}
+		g, eg := hyp_gamma_ratio([]float64{m, c}, []float64{a + m, b + m})
+		res = g * sum
+		err = hyp_eps*math.Abs(g)*abs + eg*math.Abs(sum)
+	}
+
+	// The logarithmic series, with d = D[k] - Log(w).
+	lw := math.Log(math.Abs(w))
+	sum := 0.0
+	abs := 0.0
+	t := GammaRatio([]float64{}, []float64{m + 1}) // (a+m)_k (b+m)_k / (k! (k+m)!) w**k
+	d := Digamma(a+m) + Digamma(b+m) - Digamma(1) - Digamma(m+1)
+	term := 0.0
+	for k := 0.0; k < maxiter; k++ {
+		term = t * (lw + d)
+		sum += term
+		abs += math.Abs(t) * (math.Abs(lw) + math.Abs(d))
+		if math.Abs(term) < tol*math.Abs(sum) {
+			break
+		}
+		t *= (a + m + k) * (b + m + k) / ((k + 1) * (k + m + 1)) * w
+		d += 1/(a+m+k) + 1/(b+m+k) - 1/(k+1) - 1/(k+m+1)
+	}
+	g, eg := hyp_gamma_ratio([]float64{c}, []float64{a, b})
+	g, eg = hyp2f1_scale(g, eg, math.Pow(-w, m), m*lw)
+	return res - g*sum, err + math.Abs(g)*(hyp_eps*abs+math.Abs(term)) + eg*math.Abs(sum)
+}
+
+// hyp2f1_inverse_x returns the principal value of 2F1(a, b; c; x) for x ≥ 3/2 using the
+// transformation to 1/x,
+//
+//	2F1(a, b; c; x) = Gamma(c) Gamma(b-a) / (Gamma(b) Gamma(c-a)) (-x)**(-a) 2F1(a, a-c+1; a-b+1; 1/x)
+//	                + Gamma(c) Gamma(a-b) / (Gamma(a) Gamma(c-b)) (-x)**(-b) 2F1(b, b-c+1; b-a+1; 1/x)
+//
+// where b-a is not an integer, and the real part of (-x)**(-a) = x**(-a) Exp(iπa) is taken.
+// For b-a within 1e-8 of an integer, the logarithmic series of hyp2f1_inverse_x_log is used
+// instead. See 15.3.7, p559, Abramowitz & Stegun.
+func hyp2f1_inverse_x(a, b, c, x, tol float64) (float64, float64) {
+	const mtol = 1e-8
+
+	if a > b {
+		a, b = b, a
+	}
+	if m := math.Round(b - a); math.Abs(b-a-m) < mtol {
+		res, err := hyp2f1_inverse_x_log(a, m, c, x, tol)
+		// The error from replacing b-a by m.
+		err += math.Abs(b-a-m) * (1 + math.Log(x)) * math.Abs(res)
+		return res, err
+	}
+
+	y := 1 / x
+	lx := math.Log(x)
+	f1, e1 := hyp2f1(a, a-c+1, a-b+1, y, math.MaxInt32, tol, false)
+	f2, e2 := hyp2f1(b, b-c+1, b-a+1, y, math.MaxInt32, tol, false)
+	g1, eg1 := hyp_gamma_ratio([]float64{c, b - a}, []float64{b, c - a})
+	g1, eg1 = hyp2f1_scale(g1, eg1, cosPi(a)*math.Pow(x, -a), a*lx)
+	g2, eg2 := hyp_gamma_ratio([]float64{c, a - b}, []float64{a, c - b})
+	g2, eg2 = hyp2f1_scale(g2, eg2, cosPi(b)*math.Pow(x, -b), b*lx)
+	return g1*f1 + g2*f2, math.Abs(g1)*e1 + eg1*math.Abs(f1) + math.Abs(g2)*e2 + eg2*math.Abs(f2)
+}
+
+// hyp2f1_inverse_x_log returns the principal value of 2F1(a, a+m; c; x) for integer m ≥ 0
+// and x > 1, which is the real part of
+//
+//	                                                    m-1
+//	2F1(a, a+m; c; x) = Gamma(c) (-x)**(-a) / Gamma(a+m) ∑ (a)_k (m-k-1)! / (k! Gamma(c-a-k)) x**(-k)
+//	                                                    k=0
+//
+//	                                                   ∞
+//	                  + Gamma(c) (-x)**(-a) / Gamma(a) ∑ E[k] (Log(-x) + D[k] - Digamma(c-a-m-k))
+//	                                                  k=0
+//
+// where E[k] = (a+m)_k / (k! (k+m)! Gamma(c-a-m-k)) (-1)**k x**(-k-m), D[k] = Digamma(1+m+k) +
+// Digamma(1+k) - Digamma(a+m+k) and Log(-x) = Log(x) - iπ. The products of 1/Gamma and Digamma
+// at c-a-m-k are computed by recurrence, so that the poles cancel. It also returns an estimate
+// of the absolute error. See 15.8.8, Digital Library of Mathematical Functions
+// (https://dlmf.nist.gov/15.8).
+func hyp2f1_inverse_x_log(a, m, c, x, tol float64) (float64, float64) {
+	const maxiter = 1000
+
+	// The finite sum.
+	fin := 0.0
+	absfin := 0.0
+	t := 1.0 // (a)_k / k! x**(-k)
+	for k := 0.0; k < m; k++ {
+		u := t * GammaRatio([]float64{m - k}, []float64{c - a - k})
+		fin += u
+		absfin += math.Abs(u)
+		t *= (a + k) / ((k + 1) * x)
+	}
+	gm, egm := hyp_gamma_ratio([]float64{}, []float64{a + m})
+
+	// The logarithmic series, with e = E[k], g = E[k] Digamma(c-a-m-k) and d = D[k].
+	y := c - a - m
+	e := GammaRatio([]float64{}, []float64{m + 1, y}) * math.Pow(x, -m)
+	g := Digamma(y) * e
+	d := Digamma(1+m) + Digamma(1) - Digamma(a+m)
+	lx := math.Log(x)
+	sum := 0.0  // ∑ E[k] (Log(x) + D[k]) - g
+	sume := 0.0 // ∑ E[k]
+	abs := 0.0
+	term := 0.0
+	for k := 0.0; k < maxiter; k++ {
+		term = e*(lx+d) - g
+		sum += term
+		sume += e
+		abs += math.Abs(e)*(lx+math.Abs(d)) + math.Abs(g)
+		if math.Abs(term) < tol*math.Abs(sum) && math.Abs(e) < tol*math.Abs(sume) {
+			break
+		}
+		r := -(a + m + k) / ((k + 1) * (k + m + 1) * x)
+		g = r * ((y-k-1)*g - e)
+		e *= r * (y - k - 1)
+		d += 1/(1+m+k) + 1/(1+k) - 1/(a+m+k)
 	}
-	return scale * res
+	ga, ega := hyp_gamma_ratio([]float64{}, []float64{a})
+	ca := cosPi(a)
+	sa := math.Pi * sinPi(a)
+	res := ca*(gm*fin+ga*sum) + sa*ga*sume
+	err := math.Abs(ca)*(egm*math.Abs(fin)+math.Abs(gm)*hyp_eps*absfin+ega*math.Abs(sum)) +
+		math.Abs(ga)*(math.Abs(ca)*(hyp_eps*abs+math.Abs(term))+math.Abs(sa)*hyp_eps*math.Abs(sume)) +
+		ega*math.Abs(sa*sume)
+	gc, egc := hyp_gamma_ratio([]float64{c}, []float64{})
+	gc, egc = hyp2f1_scale(gc, egc, math.Pow(x, -a), a*lx)
+	return gc * res, math.Abs(gc)*err + egc*math.Abs(res)
 }
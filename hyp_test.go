@@ -2,6 +2,7 @@ package special_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	. "github.com/scientificgo/special"
@@ -55,9 +56,8 @@ func Test0F1(t *testing.T) {
 		{[]float64{}, []float64{-777.7}, 255.5, 0.7200292734914648},
 		{[]float64{}, []float64{-7777.7}, -2555.5, 1.3889867337813122},
 		{[]float64{}, []float64{-7.70000000000000017763568394}, -77.76999999999999602096067974, 1768.94756997500223569327810},
-		// FIXME: function fails these tests.
-		// {[]float64{}, []float64{-7.70000000000000017763568394}, -154, 3516.22532336018217973841307},
-		// {[]float64{}, []float64{-7.70000000000000017763568394}, -770, 7.051795763569786e+07},
+		{[]float64{}, []float64{-7.70000000000000017763568394}, -154, 3516.22532336018217973841307},
+		{[]float64{}, []float64{-7.70000000000000017763568394}, -770, 7.051795763569786e+07},
 	}
 	for i, c := range cases {
 		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
@@ -116,17 +116,15 @@ func Test1F1(t *testing.T) {
 		{[]float64{-999}, []float64{-1000}, 0.005, 1.0050074957967967},
 		{[]float64{-543.43}, []float64{-532.32}, -324.432, -1.1198647713125607e+141},
 		{[]float64{-10}, []float64{1}, 5, 1.7562761794532629},
-
-		// FIXME function fails these tests.
-		// {[]float64{-50}, []float64{5}, 5, 0.0005715733149410122},
-		// {[]float64{-100}, []float64{10}, 5, 2.9224953421878733e-07},
-		// {[]float64{-100}, []float64{50}, 5, 3.982104307130384e-06},
-		// {[]float64{-1000}, []float64{100}, 5, 2.3849951368733384e-29},
-		// {[]float64{-10000}, []float64{1000}, 5, 4.389355758473254e-23},
-		// {[]float64{-100000}, []float64{10000}, 5, 1.6793620777269317e-22},
-		// {[]float64{-100000}, []float64{20000}, 5, 1.3629514086965236e-11},
-		// {[]float64{-100000}, []float64{30000}, 5, 5.743051141607582e-08},
-		// {[]float64{-100000}, []float64{50000}, 5, 4.533185960204361e-05},
+		{[]float64{-50}, []float64{5}, 5, 0.0005715733149410122},
+		{[]float64{-100}, []float64{10}, 5, 2.9224953421878733e-07},
+		{[]float64{-100}, []float64{50}, 5, 3.982104307130384e-06},
+		{[]float64{-1000}, []float64{100}, 5, 2.3849951368733384e-29},
+		{[]float64{-10000}, []float64{1000}, 5, 4.389355758473254e-23},
+		{[]float64{-100000}, []float64{10000}, 5, 1.6793620777269317e-22},
+		{[]float64{-100000}, []float64{20000}, 5, 1.3629514086965236e-11},
+		{[]float64{-100000}, []float64{30000}, 5, 5.743051141607582e-08},
+		{[]float64{-100000}, []float64{50000}, 5, 4.533185960204361e-05},
 	}
 	for i, c := range cases {
 		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
@@ -148,8 +146,8 @@ func Test2F1(t *testing.T) {
 		{[]float64{11, 9}, []float64{-7}, 0, 1},
 		{[]float64{-11, -9}, []float64{-7}, -5, nan},
 		{[]float64{11, 9}, []float64{-7}, 0.1, nan},
-		{[]float64{-10.1, -1.02}, []float64{-5.0003}, 1.999, nan},
-		{[]float64{1, 2.2}, []float64{1}, 1.5, nan},
+		{[]float64{-10.1, -1.02}, []float64{-5.0003}, 1.999, 24419.914075158117},
+		{[]float64{1, 2.2}, []float64{1}, 1.5, 3.7172659624125894},
 		{[]float64{1, 2}, []float64{1}, 0.5, 4},
 		{[]float64{3, 4}, []float64{8}, 1, 35},
 		{[]float64{3, 4}, []float64{8}, -1, 0.320051626105340984775197726448510982379},
@@ -171,6 +169,57 @@ func Test2F1(t *testing.T) {
 	}
 }
 
+func TestHyp2F1(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, In4, Out float64
+	}{
+		{nan, 1, 2, 0.5, nan},
+		{1, 1, 2, 0.9, 2.5584278811044956},
+		{1, 1, 2, -3, 0.4620981203732969},
+		{1, 1, 2, 3, -0.23104906018664845},
+		{1, 1, 2, 1.2, 1.3411982603617505},
+		{0.5, 0.5, 1.5, 2, 1.1107207345395915},
+		{0.3, 0.7, 1.5, 0.95, 1.3145776637094362},
+		{0.3, 0.7, 1.5, -50, 0.44994059973550454},
+		{0.3, 0.7, 1.5, 1.3, 1.3171620578135346},
+		{0.3, 0.7, 1.5, 10, 0.593129103425216},
+		{0.3, 1.3, 2.5, -10, 0.6079911886078915},
+		{0.3, 1.3, 2.6, 0.9, 1.255447800379099},
+		{0.3, 1.3, -0.4, 1.2, -41.954497833418166},
+		{2, 3, 4, 5, 0.15654212933375475},
+		{2, 3, 4, 0.99, 280.6753164581961},
+		{2, 3, 7, 1.01, 5.335857816470669},
+		{2, 3, 7, 2.5, -4.0},
+		{2.5, 3.5, 7, 2.5, -0.8346139666460303},
+		{1.5, 2.5, 1, 1.7, -6.132874521526663},
+		{-1.5, 2.5, 1.25, 5.7, 0.0002040759282878256},
+		{-1.5, -2.5, -1.25, 3.7, 21.851134809735548},
+		{1.5, 3.25, 2.5, 4, 0.012189917566410954},
+		{5, -2.5, 4.5, 30, -3.294119935326088e-11},
+		{-0.15, 0.85, 0.85, 1.3, 0.7437878372033235},
+		{1, 1, 2, 1, +inf},
+		{-0.5, 1, 0.25, 1, -inf},
+		{0.5, 0.5, 1.5, 1, 1.5707963267948966},
+		{2, 1.5, -0.5, -4, -0.3184},
+		{0.5, 1.5, 2 + 1e-7, 0.97, 2.7774051959148937},
+		{1.5, 1.5, 3 + 1e-7, 0.97, 6.3366020247473717},
+		{1.5, 1.5, 3 - 1e-7, 0.97, 6.3366035437698455},
+		{0.5, 1.5, 2.00001, 0.8, 1.7168223155364043},
+		{0.3, 0.7, -0.9999, 0.9, -204981.80131897994},
+		{0.3, 0.7, 1.0001, 1.2, 1.3094220431470394},
+		{0.3, 0.7, 3.0000001, 1.3, 1.1772675416130161},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Hyp2F1(c.In1, c.In2, c.In3, c.In4)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
 func Test4F2(t *testing.T) {
 	cases := []struct {
 		In1, In2 []float64
@@ -215,3 +264,66 @@ func Test8F3(t *testing.T) {
 		})
 	}
 }
+
+func TestHypPFQErr(t *testing.T) {
+	cases := []struct {
+		In1, In2      []float64
+		In3, Out, Err float64
+	}{
+		{[]float64{}, []float64{-7.7}, -770, 7.05179576356979e+07, 1e-7},
+		{[]float64{-1000}, []float64{100}, 5, 2.3849951368733384e-29, 1e-40},
+		{[]float64{0.3, 0.7}, []float64{1.5}, 10, 0.593129103425216, 1e-14},
+		{[]float64{2, 3}, []float64{4}, 0.99, 280.6753164581961, 1e-11},
+		{[]float64{1, 1, 1}, []float64{2, 2}, 0.999, 1.6386612665426603, 1e-12},
+		{[]float64{1, 1, 1}, []float64{2, 2}, 1, 1.6449340668482264, 1e-8},
+		{[]float64{1, 1, 1}, []float64{2, 2}, -1, 0.8224670334241132, 1e-14},
+		{[]float64{1, 1, 1}, []float64{2, 2}, -5, 0.5498558252121617, 1e-9},
+		{[]float64{1, 1}, []float64{}, -0.1, 0.9156333393978808, 1e-14},
+		{[]float64{1, 1}, []float64{}, -0.2, 0.852110881423661, 1e-12},
+		{[]float64{1, 1}, []float64{}, -0.5, 0.7226572337764452, 1e-7},
+		{[]float64{1, 1}, []float64{}, 0.5, nan, nan},
+		{[]float64{1, 1, 1}, []float64{2, 2}, 1.5, nan, nan},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res, err := HypPFQErr(c.In1, c.In2, c.In3)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+			if math.IsNaN(c.Err) != math.IsNaN(err) || math.Abs(res-c.Out) > err || err > c.Err {
+				tt.Errorf("Got error %v for %v, want at most %v", err, math.Abs(res-c.Out), c.Err)
+			}
+		})
+	}
+}
+
+func TestHypPFQRegularized(t *testing.T) {
+	cases := []struct {
+		In1, In2 []float64
+		In3, Out float64
+	}{
+		{[]float64{nan}, []float64{-1}, 0.5, nan},
+		{[]float64{1}, []float64{nan}, 0.5, nan},
+		{[]float64{1, 1}, []float64{2}, 0.5, 1.3862943611198906},
+		{[]float64{1}, []float64{2.5}, 1, 1.1623190852077254},
+		{[]float64{}, []float64{-0.5}, 1, 0.9849410530002364},
+		{[]float64{1}, []float64{0}, 1, 2.718281828459045},
+		{[]float64{1}, []float64{0}, 0, 0},
+		{[]float64{}, []float64{-1}, 1, 0.6889484476987382},
+		{[]float64{1.5}, []float64{-3}, -2, 4.159215237251507},
+		{[]float64{0.5, 1.5}, []float64{-1}, 0.3, 0.35948083696873373},
+		{[]float64{0.5, 1.5}, []float64{-2}, -0.7, -0.1754700605794524},
+		{[]float64{-2, 1}, []float64{-3}, 0.4, 0},
+		{[]float64{1, 1, 1}, []float64{2, -1}, 0.5, 0.7725887222397813},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := HypPFQRegularized(c.In1, c.In2, c.In3)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
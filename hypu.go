@@ -0,0 +1,384 @@
+package special
+
+import "math"
+
+// HypU returns the confluent hypergeometric function of the second kind, or Tricomi function,
+// defined by
+//
+//	                                      ∞
+//	HypU(a, b, x) = [1 / Gamma(a)] ∫ dt Exp(-xt) t**(a-1) (1+t)**(b-a-1)
+//	                                     t=0
+//
+// for a > 0 and x > 0, and by analytic continuation for all real a and b. HypU is the
+// solution of Kummer's equation, x d²w/dx² + (b-x) dw/dx - a w = 0, with HypU(a, b, x) ~
+// x**(-a) as x → ∞, and is related to the regularised 1F1 by
+//
+//	HypU(a, b, x) = π / Sin(πb) [M(a, b, x) / Gamma(a-b+1) - x**(1-b) M(a-b+1, 2-b, x) / Gamma(a)]
+//
+// where M(a, b, x) = 1F1(a; b; x) / Gamma(b). In particular, GammaIncU(a, x) =
+// x**a Exp(-x) HypU(1, 1+a, x).
+//
+// See http://mathworld.wolfram.com/ConfluentHypergeometricFunctionoftheSecondKind.html
+// for more information.
+func HypU(a, b, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(a) || math.IsNaN(b) || math.IsNaN(x) || math.IsInf(a, 0) || math.IsInf(b, 0) || x < 0:
+		return math.NaN()
+	case math.IsInf(x, 1):
+		switch {
+		case a > 0:
+			return 0
+		case a == 0:
+			return 1
+		}
+		return math.Inf(1)
+	case isNonPosInt(a):
+		return hyp_u_poly(-a, b, x)
+	case isNonPosInt(a - b + 1):
+		// Kummer's transformation, HypU(a, b, x) = x**(1-b) HypU(a-b+1, 2-b, x).
+		return math.Pow(x, 1-b) * hyp_u_poly(b-a-1, 2-b, x)
+	case x == 0:
+		if b < 1 {
+			return GammaRatio([]float64{1 - b}, []float64{a - b + 1})
+		}
+		return math.Copysign(math.Inf(1), float64(GammaSign(a)))
+	}
+
+	const (
+		xmin = 0.5
+		xasy = 30
+	)
+
+	if x >= xasy {
+		if res, ok := hyp_u_asymptotic(a, b, x); ok {
+			return res
+		}
+	}
+	if x < xmin {
+		return hyp_u_series(a, b, x)
+	}
+	return hyp_u_cf(a, b, x)
+}
+
+// hyp_u_poly returns HypU(-n, b, x) for integer n ≥ 0, which is the polynomial
+//
+//	                          n
+//	HypU(-n, b, x) = (-1)**n ∑ C(n, k) Poch(b+k, n-k) (-x)**k
+//	                         k=0
+//
+// See 13.2.7, Digital Library of Mathematical Functions (https://dlmf.nist.gov/13.2).
+func hyp_u_poly(n, b, x float64) float64 {
+	// Horner's method, with c[n] = 1 and c[k-1] = c[k] k (b+k-1) / (n-k+1), which avoids
+	// dividing by b+k-1 when b is a non-positive integer.
+	c := 1.0
+	res := c
+	for k := n; k > 0; k-- {
+		c *= k * (b + k - 1) / (n - k + 1)
+		res = c - res*x
+	}
+	if int(n)&1 == 1 {
+		res = -res
+	}
+	return res
+}
+
+// hyp_u_asymptotic returns HypU(a, b, x) for large x using the asymptotic expansion
+//
+//	                       ∞
+//	HypU(a, b, x) ~ x**(-a) ∑ Poch(a, k) Poch(a-b+1, k) / k! (-x)**(-k)
+//	                      k=0
+//
+// and reports whether the terms became small enough before they started to grow.
+// See 13.7.3, Digital Library of Mathematical Functions (https://dlmf.nist.gov/13.7).
+func hyp_u_asymptotic(a, b, x float64) (float64, bool) {
+	const (
+		maxiter = 200
+		tol     = 1e-17
+	)
+
+	res := 1.0
+	term := 1.0
+	for k := 0.0; k < maxiter; k++ {
+		next := -term * (a + k) * (a - b + 1 + k) / ((k + 1) * x)
+		if math.Abs(next) < tol*math.Abs(res) {
+			return res * math.Pow(x, -a), true
+		}
+		if math.Abs(next) > math.Abs(term) {
+			break
+		}
+		term = next
+		res += term
+	}
+	return 0, false
+}
+
+// hyp_u_series returns HypU(a, b, x) for small x. For non-integer b it uses the connection
+// formula with 1F1,
+//
+//	HypU(a, b, x) = Gamma(1-b) / Gamma(a-b+1) 1F1(a; b; x)
+//	              + Gamma(b-1) / Gamma(a) x**(1-b) 1F1(a-b+1; 2-b; x)
+//
+// which loses accuracy as b approaches an integer. For integer b = n+1 ≥ 1 it uses
+//
+//	                 (-1)**(n+1)    ∞
+//	HypU(a, b, x) = ------------- ∑ Poch(a, k) / (Poch(n+1, k) k!) x**k D[k]
+//	                n! Gamma(a-n) k=0
+//
+//	                                 n
+//	                + [1 / Gamma(a)] ∑ (k-1)! Poch(1-a+k, n-k) / (n-k)! x**(-k)
+//	                                k=1
+//
+// where D[k] = Log(x) + Digamma(a+k) - Digamma(1+k) - Digamma(n+1+k). For b within epsmax
+// of an integer it uses hyp_u_series_near instead, and for b closer to an integer m ≤ 0 than
+// to any other, Kummer's transformation HypU(a, b, x) = x**(1-b) HypU(a-b+1, 2-b, x).
+// See 13.2.9 and 13.2.42, Digital Library of Mathematical Functions (https://dlmf.nist.gov/13.2).
+func hyp_u_series(a, b, x float64) float64 {
+	const (
+		maxiter = 500
+		tol     = 1e-17
+		epsmax  = 0.01
+	)
+
+	m := math.Round(b)
+	eps := b - m
+	if math.Abs(eps) >= epsmax {
+		m1, _ := hyp1f1(a, b, x, math.MaxInt32, tol, false)
+		m2, _ := hyp1f1(a-b+1, 2-b, x, math.MaxInt32, tol, false)
+		return GammaRatio([]float64{1 - b}, []float64{a - b + 1})*m1 +
+			GammaRatio([]float64{b - 1}, []float64{a})*math.Pow(x, 1-b)*m2
+	}
+	if m <= 0 {
+		return math.Pow(x, 1-b) * hyp_u_series(a-b+1, 2-b, x)
+	}
+	if eps != 0 {
+		return hyp_u_series_near(a, m-1, eps, x)
+	}
+
+	n := b - 1
+	lx := math.Log(x)
+
+	// The logarithmic series, with d = D[k] - Log(x).
+	sum := 0.0
+	c := 1.0 // Poch(a, k) / (Poch(n+1, k) k!) x**k
+	d := Digamma(a) - Digamma(1) - Digamma(n+1)
+	for k := 0.0; k < maxiter; k++ {
+		term := c * (lx + d)
+		sum += term
+		if math.Abs(term) < tol*math.Abs(sum) {
+			break
+		}
+		c *= (a + k) / ((n + 1 + k) * (k + 1)) * x
+		d += 1/(a+k) - 1/(k+1) - 1/(n+1+k)
+	}
+	lg, sg := math.Lgamma(a - n)
+	lf, _ := math.Lgamma(n + 1)
+	sum *= float64(sg) * math.Exp(-lg-lf)
+	if int(n)&1 == 0 {
+		sum = -sum
+	}
+
+	// The finite sum of negative powers of x.
+	if n > 0 {
+		fin := 0.0
+		for k := 1.0; k <= n; k++ {
+			p := 1.0 // Poch(1-a+k, n-k) / (n-k)!
+			for i := 0.0; i < n-k; i++ {
+				p *= (1 - a + k + i) / (i + 1)
+			}
+			lk, _ := math.Lgamma(k)
+			fin += math.Exp(lk-k*lx) * p
+		}
+		lga, sga := math.Lgamma(a)
+		sum += float64(sga) * math.Exp(-lga) * fin
+	}
+	return sum
+}
+
+// hyp_u_series_near returns HypU(a, n+1+ε, x) for integer n ≥ 0 and small ε ≠ 0. The terms
+// of the two series in the connection formula of hyp_u_series have poles in ε, which cancel
+// when the kth term of the first is paired with the (k+n)th term of the second. The result is
+//
+//	                    π       ∞
+//	HypU(a, b, x) = ---------- ∑ c[k] Expm1(L[k]) + F(x)
+//	                 Sin(πε)  k=0
+//
+// where c[k] = (-1)**n Poch(a, k) x**k / (k! Gamma(a-n-ε) Gamma(n+1+k+ε)), F(x) is the sum of
+// the first n terms of the second series, and
+//
+//	L[k] = Log(Gamma(a+k-ε) / Gamma(a+k)) - ε Log(x)
+//	     - Log(Gamma(1+k-ε) / Gamma(1+k)) + Log(Gamma(n+1+k+ε) / Gamma(n+1+k))
+//
+// which is computed accurately relative to ε using hyp_u_lgamma_diff.
+func hyp_u_series_near(a, n, eps, x float64) float64 {
+	const (
+		maxiter = 500
+		tol     = 1e-17
+	)
+
+	lx := math.Log(x)
+
+	// The paired series. The ratios of Gamma functions in Exp(L[k]) may be negative when a+k
+	// is close to a pole, in which case s is -1.
+	l0, s0 := hyp_u_lgamma_diff(a, -eps)
+	l1, _ := hyp_u_lgamma_diff(1, -eps)
+	l2, _ := hyp_u_lgamma_diff(n+1, eps)
+	l := l0 - eps*lx - l1 + l2
+	s := s0
+	lg, sg := math.Lgamma(a - n - eps)
+	lc, _ := math.Lgamma(n + 1 + eps)
+	c := float64(sg) * math.Exp(-lg-lc) // c[k]
+	if int(n)&1 == 1 {
+		c = -c
+	}
+	sum := 0.0
+	for k := 0.0; k < maxiter; k++ {
+		var term float64
+		if s > 0 {
+			term = c * math.Expm1(l)
+		} else {
+			term = -c * (math.Exp(l) + 1)
+		}
+		sum += term
+		if math.Abs(term) < tol*math.Abs(sum) {
+			break
+		}
+		c *= (a + k) * x / ((k + 1) * (n + 1 + k + eps))
+		if t := 1 - eps/(a+k); t < 0 {
+			s = -s
+			l += math.Log(-t)
+		} else {
+			l += math.Log1p(-eps / (a + k))
+		}
+		l += math.Log1p(eps/(n+1+k)) - math.Log1p(-eps/(k+1))
+	}
+	sum *= math.Pi / sinPi(eps)
+
+	// The first n terms of the second series, Gamma(b-1) / Gamma(a) x**(1-b) 1F1(a-b+1; 2-b; x).
+	if n > 0 {
+		fin := 0.0
+		t := 1.0 // Poch(a-n-ε, k) / (Poch(1-n-ε, k) k!) x**k
+		for k := 0.0; k < n; k++ {
+			fin += t
+			t *= (a - n - eps + k) * x / ((1 - n - eps + k) * (k + 1))
+		}
+		sum += GammaRatio([]float64{n + eps}, []float64{a}) * math.Exp(-(n+eps)*lx) * fin
+	}
+	return sum
+}
+
+// hyp_u_lgamma_diff returns the logarithm and sign of Gamma(x+d) / Gamma(x), which is
+// accurate relative to d for small d. The recurrence relation Gamma(x+1) = x Gamma(x) is
+// used to increase x to at least xmin, and then Stirling's series
+//
+//	                                                  ∞
+//	Log(Gamma(x)) ~ (x-1/2) Log(x) - x + Log(2π) / 2 + ∑ B(2k) / (2k (2k-1) x**(2k-1))
+//	                                                 k=1
+//
+// where B(n) is the nth Bernoulli number. See 5.11.1, Digital Library of Mathematical
+// Functions (https://dlmf.nist.gov/5.11).
+func hyp_u_lgamma_diff(x, d float64) (float64, int) {
+	const (
+		xmin = 10
+		tol  = 1e-17
+	)
+
+	res := 0.0
+	s := 1
+	for ; x < xmin; x++ {
+		if t := 1 + d/x; t < 0 {
+			s = -s
+			res -= math.Log(-t)
+		} else {
+			res -= math.Log1p(d / x)
+		}
+	}
+
+	l := math.Log1p(d / x)
+	res += (x-0.5)*l + d*math.Log(x+d) - d
+	f := 1.0 // (2k-2)!
+	for k, b := range bernoulli_coefficients {
+		fk := float64(2*k + 2)
+		if k > 0 {
+			f *= (fk - 3) * (fk - 2)
+		}
+		term := b * f * math.Pow(x, 1-fk) * math.Expm1((1-fk)*l)
+		res += term
+		if math.Abs(term) < tol*math.Abs(res) {
+			break
+		}
+	}
+	return res, s
+}
+
+// hyp_u_cf returns HypU(a, b, x) for x > 0 using the continued fraction for the ratio
+// r = HypU(a+1, b, x) / HypU(a, b, x), which follows from the recurrence relation
+//
+//	HypU(a-1, b, x) + (b-2a-x) HypU(a, b, x) + a(a-b+1) HypU(a+1, b, x) = 0
+//
+// since HypU is its minimal solution as a → ∞, together with the Wronskian
+//
+//	M(a, b, x) U'(a, b, x) - M'(a, b, x) U(a, b, x) = -Gamma(b) / Gamma(a) x**(-b) Exp(x)
+//
+// where M(a, b, x) = 1F1(a; b; x), U(a, b, x) = HypU(a, b, x) and U'/U = -a [1 + (b-a-1) r] / x.
+// For b < 1, Kummer's transformation is used first, and for a ≤ 0, the recurrence relation is
+// applied backwards from a+m > 0. See 13.3.7 and 13.2.34, Digital Library of Mathematical
+// Functions (https://dlmf.nist.gov/13.3).
+func hyp_u_cf(a, b, x float64) float64 {
+	const (
+		maxiter = 100000
+		rtol    = 1e-16
+		tol     = 1e-17
+		tiny    = 1e-300
+	)
+
+	if b < 1 {
+		return math.Pow(x, 1-b) * hyp_u_cf(a-b+1, 2-b, x)
+	}
+
+	m := 0.0
+	if a <= 0 {
+		m = math.Floor(-a) + 1
+	}
+	a += m
+
+	// Evaluate 1/r = f[0] - g[1] / (f[1] - g[2] / (f[2] - ...)) with f[i] = 2(a+1+i)-b+x and
+	// g[i] = (a+i)(a-b+1+i), using the modified Lentz algorithm.
+	cf := 2*(a+1) - b + x
+	c, d := cf, 0.0
+	for i := 1.0; i < maxiter; i++ {
+		gi := -(a + i) * (a - b + 1 + i)
+		fi := 2*(a+1+i) - b + x
+		d = fi + gi*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = fi + gi/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := c * d
+		cf *= delta
+		if math.Abs(delta-1) < rtol {
+			break
+		}
+	}
+	r := 1 / cf
+
+	m0, _ := hyp1f1(a, b, x, math.MaxInt32, tol, false)
+	m1, _ := hyp1f1(a+1, b+1, x, math.MaxInt32, tol, false)
+	m1 *= a / b
+	dlu := -a * (1 + (b-a-1)*r) / x
+	lga, _ := math.Lgamma(a)
+	lgb, _ := math.Lgamma(b)
+	u := math.Exp(lgb-lga+x-b*math.Log(x)) / (m1 - m0*dlu)
+
+	// Apply the recurrence relation backwards to HypU(a-m, b, x).
+	u1 := u * r
+	for k := 0.0; k < m; k++ {
+		u, u1 = (2*a-b+x)*u-a*(a-b+1)*u1, u
+		a--
+	}
+	return u
+}
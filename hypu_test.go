@@ -0,0 +1,73 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestHypU(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, Out float64
+	}{
+		{nan, 1, 1, nan},
+		{1, nan, 1, nan},
+		{1, 1, nan, nan},
+		{1, 1, -1, nan},
+		{inf, 1, 1, nan},
+		{1.5, 2, inf, 0},
+		{0, 2, inf, 1},
+		{-1.5, 2, inf, inf},
+		{1.5, 0.5, 0, 1.772453850905516},
+		{1.5, 2.5, 0, inf},
+		{0, 2.5, 3, 1},
+		{-2, 2.5, 3, -3.25},
+		{-3, -0.5, 1.5, -3},
+		{-1, 0, 1.5, 1.5},
+		{-2, 0, 1.5, -0.75},
+		{-2, -1, 0, 0},
+		{-3, -1, 2, -4},
+		{20.5, 1 + 1e-9, 0.3, 1.7648167181933568e-20},
+		{2.5, 1 + 1e-9, 0.3, 0.34102909710530777},
+		{0.3, 2 + 1e-10, 0.01, 35.290419393115123},
+		{50.5, 1e-8, 1e-4, 4.4955223571974255e-66},
+		{-2.5, 0.005, 0.2, 0.50068503952090626},
+		{0.5, -1e-9, 0.2, 0.92615743689176411},
+		{1.5, 4.5, 2, 1.2153397801643787},
+		{1.0, 1.0, 1.0, 0.5963473623231941},
+		{0.5, 1.5, 2.0, 0.7071067811865476},
+		{1.5, 2.5, 0.3, 6.085806194501846},
+		{2.3, 0.7, 0.5, 0.20471455712168574},
+		{0.7, -1.3, 0.8, 0.4421419742837162},
+		{-1.5, 2.2, 0.6, -0.06518137668732987},
+		{3.0, 3.0, 0.2, 10.746674373466119},
+		{2.0, 1.0, 0.1, 1.2161067991792969},
+		{0.5, -2.0, 0.4, 0.5527982203883005},
+		{1.5, 2.5, 3.0, 0.19245008972987526},
+		{2.3, 0.7, 5.0, 0.010848911918220992},
+		{0.7, -1.3, 8.0, 0.189153529305779},
+		{-1.5, 2.2, 4.0, 0.7851091227407738},
+		{-3.7, -0.4, 2.5, -5.477146448315819},
+		{3.0, 3.0, 10.0, 0.0007816669698940409},
+		{10.5, 2.5, 3.0, 1.7647418079436852e-10},
+		{0.25, 4.5, 7.5, 0.6835854191134012},
+		{2.0, 1.0, 2.2, 0.07408164464958719},
+		{5.0, -3.0, 1.7, 2.4658732798248965e-05},
+		{1.5, 2.5, 40.0, 0.003952847075210474},
+		{-2.5, 3.1, 50.0, 13829.451730443316},
+		{20.0, 1.5, 35.0, 6.273606048693764e-35},
+		{0.5, 0.5, 100.0, 0.09950731878244698},
+		{1.0, 2.0000001, 0.5, 2.0000001845821385},
+		{1.0, 2.0000001, 3.0, 0.3333333420694582},
+		{-0.5, 1.0, 12.0, 3.392642735568251},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := HypU(c.In1, c.In2, c.In3)
+			if !equalFloat64(res, c.Out) {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
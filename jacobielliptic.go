@@ -0,0 +1,120 @@
+package special
+
+import "math"
+
+// The implementation of JacobiSNCNDN follows the method of Bulirsch, as described in:
+// R. Bulirsch. Numerical calculation of elliptic integrals and elliptic functions.
+// Numerische Mathematik 7, 78–90 (1965).
+
+// JacobiSNCNDN returns the Jacobi elliptic functions sn, cn and dn, defined by
+//
+//	sn(u, m) = Sin(φ),    cn(u, m) = Cos(φ),    dn(u, m) = Sqrt(1 - m Sin(φ)**2)
+//
+// where φ = JacobiAmplitude(u, m) is the Jacobi amplitude, m = k**2 is the parameter and k is
+// the elliptic modulus, i.e. φ is the inverse of the incomplete elliptic integral of the
+// first kind, u = EllipticF(φ, m).
+//
+// See http://mathworld.wolfram.com/JacobiEllipticFunctions.html for more information.
+func JacobiSNCNDN(u, m float64) (sn, cn, dn float64) {
+	// Special cases.
+	switch {
+	case math.IsNaN(u) || math.IsNaN(m) || math.IsInf(m, 0):
+		return math.NaN(), math.NaN(), math.NaN()
+	case m == 1:
+		sech := 1 / math.Cosh(u)
+		return math.Tanh(u), sech, sech
+	case math.IsInf(u, 0):
+		return math.NaN(), math.NaN(), math.NaN()
+	case m == 0:
+		sn, cn = math.Sincos(u)
+		return sn, cn, 1
+	}
+
+	const (
+		maxiter = 16
+		tol     = 1e-8 // The accuracy is tol**2.
+	)
+
+	if m > 1 {
+		// Reciprocal modulus transformation. See 16.11.1-16.11.3, p573, Abramowitz & Stegun.
+		k := math.Sqrt(m)
+		sn, cn, dn = JacobiSNCNDN(k*u, 1/m)
+		return sn / k, dn, cn
+	}
+
+	// Descending Gauss transformation, using the AGM sequences a[i] and b[i] = Sqrt(mc[i]).
+	mc := 1 - m
+	var a, b [maxiter]float64
+	ai, c := 1.0, 1.0
+	dn = 1
+	n := 0
+	for ; n < maxiter; n++ {
+		a[n] = ai
+		mc = math.Sqrt(mc)
+		b[n] = mc
+		c = (ai + mc) / 2
+		if math.Abs(ai-mc) <= tol*ai {
+			break
+		}
+		mc *= ai
+		ai = c
+	}
+	if n == maxiter {
+		n--
+	}
+
+	u *= c
+	sn, cn = math.Sincos(u)
+	if sn != 0 {
+		ai = cn / sn
+		c *= ai
+		for i := n; i >= 0; i-- {
+			ai *= c
+			c *= dn
+			dn = (b[i] + ai) / (a[i] + ai)
+			ai = c / a[i]
+		}
+		ai = 1 / math.Sqrt(c*c+1)
+		sn = math.Copysign(ai, sn)
+		cn = c * sn
+	}
+	return sn, cn, dn
+}
+
+// JacobiAmplitude returns the Jacobi amplitude φ = am(u, m), defined as the inverse of the
+// incomplete elliptic integral of the first kind, i.e.
+//
+//	u = EllipticF(φ, m)
+//
+// where m = k**2 is the parameter and k is the elliptic modulus. For m ≤ 1 the amplitude is an
+// increasing function of u with JacobiAmplitude(u + 2 EllipticK(m), m) = JacobiAmplitude(u, m) + π.
+//
+// See http://mathworld.wolfram.com/JacobiAmplitude.html for more information.
+func JacobiAmplitude(u, m float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(u) || math.IsNaN(m) || math.IsInf(m, 0):
+		return math.NaN()
+	case m == 0:
+		return u
+	case m == 1:
+		// Gudermannian function.
+		return math.Atan(math.Sinh(u))
+	case math.IsInf(u, 0):
+		if m > 1 {
+			return math.NaN()
+		}
+		return u
+	}
+
+	sn, cn, _ := JacobiSNCNDN(u, m)
+	phi := math.Atan2(sn, cn)
+	if m > 1 {
+		// cn(u, m) > 0, so the amplitude lies in (-π/2, π/2).
+		return phi
+	}
+
+	// Choose the branch of Atan2 closest to the linear part of the amplitude, π u / (2K).
+	j := math.Round((math.Pi*u/(2*EllipticK(m)) - phi) / (2 * math.Pi))
+	return phi + 2*math.Pi*j
+}
@@ -0,0 +1,77 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestJacobiSNCNDN(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out1, Out2, Out3 float64
+	}{
+		{nan, 0.5, nan, nan, nan},
+		{0.5, nan, nan, nan, nan},
+		{inf, 0.5, nan, nan, nan},
+		{0.5, inf, nan, nan, nan},
+		{0, 0.5, 0, 1, 1},
+		{1, 0, 0.8414709848078965, 0.5403023058681398, 1},
+		{1, 1, 0.7615941559557649, 0.6480542736638855, 0.6480542736638855},
+		{0.5, 0.3, 0.47421562271182061, 0.88040873642646245, 0.96567896474595116},
+		{1, 0.5, 0.80300182489564387, 0.59597656767214069, 0.82316100163159622},
+		{-2, 0.9, -0.98161586951849378, 0.19086719128611748, 0.36439985762690169},
+		{10, 0.1, -0.31910997399955998, -0.9477176924031756, 0.99489541281955862},
+		{3, 0.999999, 0.99505499504790063, 0.099325509463694936, 0.099330493629427571},
+		{1.5, -2, 0.85248510463565308, -0.52275151494217564, 1.5663529957360571},
+		{0.7, 3, 0.52430555213954355, 0.8515302038070337, 0.41870164077412597},
+		{-4, 10, 0.078285562215404073, 0.99693097591990698, 0.96887238968100486},
+		{100, 0.7, 0.36743921506381821, 0.93004753815774666, 0.95157337933724251},
+		{2.5, 0.5, 0.89061518822609431, -0.45475772286020444, 0.77678973554656294},
+		{0.001, 0.8, 0.00099999970000010704, 0.99999950000017501, 0.99999960000015997},
+		{20, 0.99, 0.97657149649188468, -0.21519319747520083, 0.23631553295796021},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			sn, cn, dn := JacobiSNCNDN(c.In1, c.In2)
+			ok := equalFloat64(sn, c.Out1) && equalFloat64(cn, c.Out2) && equalFloat64(dn, c.Out3)
+			if !ok {
+				tt.Errorf("Got (%v, %v, %v), want (%v, %v, %v)", sn, cn, dn, c.Out1, c.Out2, c.Out3)
+			}
+		})
+	}
+}
+
+func TestJacobiAmplitude(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 0.5, nan},
+		{0.5, nan, nan},
+		{inf, 0.5, inf},
+		{-inf, 0.5, -inf},
+		{inf, 2, nan},
+		{1.5, 0, 1.5},
+		{1, 1, 0.86576948323965862},
+		{0.5, 0.3, 0.49407289371104723},
+		{1, 0.5, 0.93231507988385387},
+		{-2, 0.9, -1.378750823589225},
+		{10, 0.1, 9.7495681737382807},
+		{3, 0.999999, 1.4713067710894492},
+		{0.7, 3, 0.55189937881429729},
+		{-4, 10, 0.078365747418691442},
+		{100, 0.7, 75.774477812718857},
+		{2.5, 0.5, 2.0428964885549359},
+		{0.001, 0.8, 0.00099999986666669859},
+		{20, 0.99, 8.0708712918978609},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := JacobiAmplitude(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
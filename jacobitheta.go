@@ -0,0 +1,282 @@
+package special
+
+import "math"
+
+// JacobiTheta1 returns the Jacobi theta function θ1, defined by
+//
+//	                                ∞
+//	JacobiTheta1(z, q) = 2 q**(1/4) ∑ (-1)**n q**(n(n+1)) Sin((2n+1)z)
+//	                               n=0
+//
+// for real z and nome 0 ≤ q < 1.
+//
+// See http://mathworld.wolfram.com/JacobiThetaFunctions.html for more information.
+func JacobiTheta1(z, q float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(z) || math.IsNaN(q) || math.IsInf(z, 0) || q < 0 || q >= 1:
+		return math.NaN()
+	case q == 0 || z == 0:
+		return 0
+	}
+
+	j, z := jacobi_theta_reduce(z)
+	res := jacobi_theta_sine(z, q)
+	if math.Mod(j, 2) != 0 {
+		res = -res
+	}
+	return res
+}
+
+// JacobiTheta2 returns the Jacobi theta function θ2, defined by
+//
+//	                                ∞
+//	JacobiTheta2(z, q) = 2 q**(1/4) ∑ q**(n(n+1)) Cos((2n+1)z)
+//	                               n=0
+//
+// for real z and nome 0 ≤ q < 1.
+//
+// See http://mathworld.wolfram.com/JacobiThetaFunctions.html for more information.
+func JacobiTheta2(z, q float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(z) || math.IsNaN(q) || math.IsInf(z, 0) || q < 0 || q >= 1:
+		return math.NaN()
+	case q == 0:
+		return 0
+	}
+
+	j, z := jacobi_theta_reduce(z)
+	res := jacobi_theta_odd(z, q)
+	if math.Mod(j, 2) != 0 {
+		res = -res
+	}
+	return res
+}
+
+// JacobiTheta3 returns the Jacobi theta function θ3, defined by
+//
+//	                           ∞
+//	JacobiTheta3(z, q) = 1 + 2 ∑ q**(n**2) Cos(2nz)
+//	                          n=1
+//
+// for real z and nome 0 ≤ q < 1.
+//
+// See http://mathworld.wolfram.com/JacobiThetaFunctions.html for more information.
+func JacobiTheta3(z, q float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(z) || math.IsNaN(q) || math.IsInf(z, 0) || q < 0 || q >= 1:
+		return math.NaN()
+	case q == 0:
+		return 1
+	}
+
+	_, z = jacobi_theta_reduce(z)
+	return jacobi_theta_even(z, q)
+}
+
+// JacobiTheta4 returns the Jacobi theta function θ4, defined by
+//
+//	                           ∞
+//	JacobiTheta4(z, q) = 1 + 2 ∑ (-1)**n q**(n**2) Cos(2nz)
+//	                          n=1
+//
+// for real z and nome 0 ≤ q < 1.
+//
+// See http://mathworld.wolfram.com/JacobiThetaFunctions.html for more information.
+func JacobiTheta4(z, q float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(z) || math.IsNaN(q) || math.IsInf(z, 0) || q < 0 || q >= 1:
+		return math.NaN()
+	case q == 0:
+		return 1
+	}
+
+	// θ4(z, q) = θ3(z + π/2, q).
+	_, z = jacobi_theta_reduce(z)
+	if z > 0 {
+		return jacobi_theta_even(z-math.Pi/2, q)
+	}
+	return jacobi_theta_even(z+math.Pi/2, q)
+}
+
+// EllipticNome returns the nome q corresponding to the parameter m, defined by
+//
+//	EllipticNome(m) = Exp(-π EllipticK(1-m) / EllipticK(m)) = Exp(-π EllipticKRatio(m))
+//
+// for 0 ≤ m ≤ 1, where m = k**2 and k is the elliptic modulus.
+//
+// See http://mathworld.wolfram.com/Nome.html for more information.
+func EllipticNome(m float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(m) || m < 0 || m > 1:
+		return math.NaN()
+	case m == 0:
+		return 0
+	case m == 1:
+		return 1
+	}
+	return math.Exp(-math.Pi * EllipticKRatio(m))
+}
+
+// EllipticNomeInv returns the parameter m corresponding to the nome q, i.e. the inverse of
+// EllipticNome, defined by
+//
+//	EllipticNomeInv(q) = [JacobiTheta2(0, q) / JacobiTheta3(0, q)]**4
+//
+// for 0 ≤ q ≤ 1.
+//
+// See http://mathworld.wolfram.com/Nome.html for more information.
+func EllipticNomeInv(q float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(q) || q < 0 || q > 1:
+		return math.NaN()
+	case q == 0:
+		return 0
+	case q == 1:
+		return 1
+	}
+	r := JacobiTheta2(0, q) / JacobiTheta3(0, q)
+	r *= r
+	return r * r
+}
+
+// EllipticKRatio returns the ratio of the complete elliptic integrals of the first kind
+// with complementary parameters, defined by
+//
+//	EllipticKRatio(m) = EllipticK(1-m) / EllipticK(m) = AGM(1, Sqrt(1-m)) / AGM(1, Sqrt(m))
+//
+// for 0 ≤ m ≤ 1, where m = k**2 is the parameter, k is the elliptic modulus and AGM is the
+// arithmetic-geometric mean. The inverse is given by EllipticNomeInv(Exp(-π r)).
+//
+// See http://mathworld.wolfram.com/EllipticIntegralSingularValue.html for more information.
+func EllipticKRatio(m float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(m) || m < 0 || m > 1:
+		return math.NaN()
+	case m == 0:
+		return math.Inf(1)
+	case m == 1:
+		return 0
+	}
+	// Using AGM(1, Sqrt(m)) rather than EllipticK(1-m) avoids the rounding of 1-m for small m.
+	return agm(1, math.Sqrt(1-m)) / agm(1, math.Sqrt(m))
+}
+
+// jacobi_theta_reduce returns j and z - jπ, where j is the integer nearest to z/π,
+// such that |z - jπ| ≤ π/2.
+func jacobi_theta_reduce(z float64) (float64, float64) {
+	if math.Abs(z) <= math.Pi/2 {
+		return 0, z
+	}
+	j := math.Round(z / math.Pi)
+	return j, z - j*math.Pi
+}
+
+// jacobi_theta_even returns θ3(z, q) for |z| ≤ π.
+func jacobi_theta_even(z, q float64) float64 {
+	const tol = 1e-17
+	l := -math.Log(q)
+	if l >= math.Pi {
+		// Fourier series, converging rapidly for q ≤ Exp(-π).
+		sum := 0.0
+		qn := q  // q**(2n-1)
+		qn2 := q // q**(n**2)
+		for n := 1; qn2 > tol; n++ {
+			sum += qn2 * math.Cos(2*float64(n)*z)
+			qn *= q * q
+			qn2 *= qn
+		}
+		return 1 + 2*sum
+	}
+
+	// Jacobi imaginary transformation, from the Poisson summation formula
+	//
+	//	                       ∞
+	//	θ3(z, q) = Sqrt(π / l) ∑ Exp(-(z - kπ)**2 / l)
+	//	                      k=-∞
+	//
+	// where q = Exp(-l). For l < π and |z| ≤ π, |k| ≤ 6 suffices.
+	sum := 0.0
+	for k := -6; k <= 6; k++ {
+		d := z - float64(k)*math.Pi
+		sum += math.Exp(-d * d / l)
+	}
+	return math.Sqrt(math.Pi/l) * sum
+}
+
+// jacobi_theta_odd returns θ2(z, q) for |z| ≤ π.
+func jacobi_theta_odd(z, q float64) float64 {
+	const tol = 1e-17
+	l := -math.Log(q)
+	if l >= math.Pi {
+		// Fourier series, converging rapidly for q ≤ Exp(-π).
+		sum := 0.0
+		qn := 1.0  // q**(2n)
+		qn2 := 1.0 // q**(n(n+1))
+		for n := 0; qn2 > tol; n++ {
+			sum += qn2 * math.Cos(float64(2*n+1)*z)
+			qn *= q * q
+			qn2 *= qn
+		}
+		return 2 * math.Sqrt(math.Sqrt(q)) * sum
+	}
+
+	// Jacobi imaginary transformation, from the Poisson summation formula
+	//
+	//	                       ∞
+	//	θ2(z, q) = Sqrt(π / l) ∑ (-1)**k Exp(-(z - kπ)**2 / l)
+	//	                      k=-∞
+	//
+	// where q = Exp(-l). For l < π and |z| ≤ π, |k| ≤ 6 suffices.
+	sum := 0.0
+	for k := -6; k <= 6; k++ {
+		d := z - float64(k)*math.Pi
+		sum += float64(1-2*(k&1)) * math.Exp(-d*d/l)
+	}
+	return math.Sqrt(math.Pi/l) * sum
+}
+
+// jacobi_theta_sine returns θ1(z, q) for |z| ≤ π/2. Unlike θ2(z - π/2, q), it retains the
+// relative accuracy of z near the zero at z = 0.
+func jacobi_theta_sine(z, q float64) float64 {
+	const tol = 1e-17
+	l := -math.Log(q)
+	if l >= math.Pi {
+		// Fourier series, converging rapidly for q ≤ Exp(-π).
+		sum := 0.0
+		qn := 1.0  // q**(2n)
+		qn2 := 1.0 // q**(n(n+1))
+		for n := 0; qn2 > tol; n++ {
+			sum += float64(1-2*(n&1)) * qn2 * math.Sin(float64(2*n+1)*z)
+			qn *= q * q
+			qn2 *= qn
+		}
+		return 2 * math.Sqrt(math.Sqrt(q)) * sum
+	}
+
+	// Jacobi imaginary transformation, from the Poisson summation formula
+	//
+	//	                       ∞
+	//	θ1(z, q) = Sqrt(π / l) ∑ (-1)**k Exp(-(z - (k+1/2)π)**2 / l)
+	//	                      k=-∞
+	//
+	// where q = Exp(-l), with the terms k and -k-1 combined as
+	//
+	//	(-1)**k Exp(-(|z| - c)**2 / l) (1 - Exp(-4c|z| / l)) Sign(z)
+	//
+	// where c = (k+1/2)π. For l < π and |z| ≤ π/2, k ≤ 6 suffices.
+	a := math.Abs(z)
+	sum := 0.0
+	for k := 0; k <= 6; k++ {
+		c := (float64(k) + 0.5) * math.Pi
+		d := a - c
+		sum -= float64(1-2*(k&1)) * math.Exp(-d*d/l) * math.Expm1(-4*c*a/l)
+	}
+	return math.Copysign(math.Sqrt(math.Pi/l)*sum, z)
+}
@@ -0,0 +1,213 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestJacobiTheta1(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 0.5, nan},
+		{0.5, nan, nan},
+		{inf, 0.5, nan},
+		{0.5, -0.1, nan},
+		{0.5, 1, nan},
+		{0, 0.5, 0},
+		{0.5, 0, 0},
+		{0.5, 0.1, 0.5279836054564474},
+		{1, 0.5, 1.3303784981792746},
+		{-2, 0.3, -1.3825452883130989},
+		{10, 0.01, -0.34400667251860551},
+		{0.3, 0.9, 1.2037771411797209e-06},
+		{2, 0.99, 1.9371505024955799e-07},
+		{1.5707963267948966, 0.7, 2.967827368917376},
+		{100, 0.2, -0.62380810817452581},
+		{1e-12, 0.5, 5.4897853256034053e-13},
+		{1e-8, 0.5, 5.4897853256034056e-09},
+		{-1e-8, 0.01, -6.3226579537722802e-09},
+		{1e-12, 0.99, 2.6442499829748339e-115},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := JacobiTheta1(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestJacobiTheta2(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 0.5, nan},
+		{0.5, nan, nan},
+		{inf, 0.5, nan},
+		{0.5, -0.1, nan},
+		{0.5, 1, nan},
+		{0.5, 0, 0},
+		{0.5, 0.1, 0.98779654963589081},
+		{1, 0.5, 0.50019813851445616},
+		{-2, 0.3, -0.48896252716858973},
+		{10, 0.01, -0.53066567461746683},
+		{0.3, 0.9, 2.3241134727640169},
+		{2, 0.99, -8.555158867102534e-56},
+		{0, 0.7, 2.967827368917376},
+		{100, 0.2, 1.1520769582900872},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := JacobiTheta2(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestJacobiTheta3(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 0.5, nan},
+		{0.5, nan, nan},
+		{inf, 0.5, nan},
+		{0.5, -0.1, nan},
+		{0.5, 1, nan},
+		{0.5, 0, 1},
+		{0.5, 0.1, 1.1079772298263333},
+		{1, 0.5, 0.50589388573048466},
+		{-2, 0.3, 0.60548993784432181},
+		{10, 0.01, 1.0081616278975065},
+		{0.3, 0.9, 2.3241134727640169},
+		{2, 0.99, 8.555158867102534e-56},
+		{0, 0.7, 2.9678273689287802},
+		{100, 0.2, 1.1931930987132302},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := JacobiTheta3(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestJacobiTheta4(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 0.5, nan},
+		{0.5, nan, nan},
+		{inf, 0.5, nan},
+		{0.5, -0.1, nan},
+		{0.5, 1, nan},
+		{0.5, 0, 1},
+		{0.5, 0.1, 0.89185631143904753},
+		{1, 0.5, 1.3306863284854333},
+		{-2, 0.3, 1.3897958445706389},
+		{10, 0.01, 0.99183834542497096},
+		{0.3, 0.9, 1.2037771820885567e-06},
+		{2, 0.99, 1.9371505024955799e-07},
+		{0, 0.7, 0.005876410710348866},
+		{100, 0.2, 0.80344500470771019},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := JacobiTheta4(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestEllipticNome(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{-0.1, nan},
+		{1.1, nan},
+		{0, 0},
+		{1, 1},
+		{0.5, 0.043213918263772251},
+		{0.1, 0.006584651553858371},
+		{1e-10, 6.2500000003125e-12},
+		{0.9, 0.14017312695426157},
+		{0.999999, 0.55157301902989742},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := EllipticNome(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestEllipticNomeInv(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{-0.1, nan},
+		{1.1, nan},
+		{0, 0},
+		{1, 1},
+		{0.1, 0.80240329821757628},
+		{0.01, 0.14787439154361495},
+		{1e-10, 1.5999999987200001e-09},
+		{0.5, 0.9999895221373104},
+		{0.9, 1},
+		{0.0432, 0.49988780750834139},
+		{0.99, 1},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := EllipticNomeInv(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestEllipticKRatio(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{-0.1, nan},
+		{1.1, nan},
+		{0, inf},
+		{1, 0},
+		{0.5, 1},
+		{0.1, 1.5988749701776019},
+		{1e-10, 8.2118983893889688},
+		{0.9, 0.62543977399866391},
+		{0.999999, 0.18938834975738142},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := EllipticKRatio(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
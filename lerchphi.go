@@ -0,0 +1,351 @@
+package special
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// LerchPhi returns the Lerch transcendent, defined by
+//
+//	                    ∞
+//	LerchPhi(z, s, a) = ∑ z**k / (k+a)**s
+//	                   k=0
+//
+// for -1 ≤ z ≤ 1 and a > 0 where the series converges, and by analytic continuation in s
+// for z = -1. LerchPhi generalises the Hurwitz zeta function, HurwitzZeta(s, a) =
+// LerchPhi(1, s, a), and the polylogarithm, Polylog(s, z) = z LerchPhi(z, s, 1).
+//
+// See http://mathworld.wolfram.com/LerchTranscendent.html for more information.
+func LerchPhi(z, s, a float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(z) || math.IsNaN(s) || math.IsNaN(a) || math.IsInf(s, -1) || math.Abs(z) > 1 || a <= 0:
+		return math.NaN()
+	case s == 0:
+		return 1 / (1 - z)
+	case math.IsInf(a, 1):
+		if s > 0 {
+			return 0
+		}
+		return math.NaN()
+	case z == 0:
+		return math.Pow(a, -s)
+	case math.IsInf(s, 1):
+		switch {
+		case a < 1:
+			return math.Inf(1)
+		case a == 1:
+			return 1
+		}
+		return 0
+	case z == 1:
+		if s > 1 {
+			return HurwitzZeta(s, a)
+		}
+		return math.Inf(1)
+	}
+
+	const zmax = 0.5
+
+	switch {
+	case z < 0 && s > 0:
+		// The terms |z|**k / (k+a)**s are a moment sequence, so the alternating series
+		// converges rapidly with alternatingSum.
+		const n = 25
+		return alternatingSum(n, func(k int) float64 {
+			fk := float64(k)
+			return math.Exp(fk*math.Log(-z) - s*math.Log(fk+a))
+		})
+	case z == -1:
+		return math.Pow(2, -s) * (HurwitzZeta(s, a/2) - HurwitzZeta(s, (a+1)/2))
+	case z < 0:
+		return lerch_phi_negative(z, s, a)
+	case z <= zmax:
+		return lerch_phi_series(z, s, a)
+	}
+	return lerch_phi_euler_maclaurin(z, s, a)
+}
+
+// lerch_phi_series returns LerchPhi(z, s, a) for 0 < z < 1 by summing the series directly.
+func lerch_phi_series(z, s, a float64) float64 {
+	const (
+		maxiter = 10000
+		tol     = 1e-17
+	)
+
+	lz := math.Log(z)
+	res := 0.0
+	prev := 0.0
+	for k := 0.0; k < maxiter; k++ {
+		term := math.Exp(k*lz - s*math.Log(k+a))
+		res += term
+		// For s < 0 the terms increase before they decrease.
+		if term < prev && term < tol*res {
+			break
+		}
+		prev = term
+	}
+	return res
+}
+
+// lerch_phi_negative returns LerchPhi(z, s, a) for -1 < z < 0 and s < 0, where the terms of
+// the series grow before they decrease and may cancel each other. The series is summed
+// directly if few digits are lost to cancellation. Otherwise, lerch_phi_transform is used for
+// s ≤ smax and, for |μ| ≤ mumax, where μ = Log(z) = Log(-z) + iπ, the series
+//
+//	                                                              ∞
+//	LerchPhi(z, s, a) = z**(-a) [Gamma(1-s) (-μ)**(s-1) + ∑ HurwitzZeta(s-k, a) μ**k / k!]
+//	                                                             k=0
+//
+// which converges for |μ| < 2π. The result is NaN if neither method is accurate.
+func lerch_phi_negative(z, s, a float64) float64 {
+	const (
+		maxiter   = 10000
+		tol       = 1e-17
+		smax      = -4
+		mumax     = 5
+		mincancel = 1e3
+		maxcancel = 1e6
+	)
+
+	lz := math.Log(-z)
+	sum := 0.0
+	abs := 0.0
+	prev := 0.0
+	for k := 0.0; k < maxiter; k++ {
+		term := math.Exp(k*lz - s*math.Log(k+a))
+		abs += term
+		if int(k)&1 == 1 {
+			sum -= term
+		} else {
+			sum += term
+		}
+		if term < prev && term < tol*abs {
+			break
+		}
+		prev = term
+	}
+	mu := complex(lz, math.Pi)
+	switch {
+	case abs <= mincancel*math.Abs(sum):
+		return sum
+	case s <= smax:
+		return lerch_phi_transform(z, s, a)
+	case cmplx.Abs(mu) > mumax:
+		if abs > maxcancel*math.Abs(sum) {
+			return math.NaN()
+		}
+		return sum
+	}
+
+	res := complex(math.Gamma(1-s), 0) * cmplx.Pow(-mu, complex(s-1, 0))
+	abs = cmplx.Abs(res)
+	c := complex(1, 0) // μ**k / k!
+	prev = math.Inf(1)
+	for k := 0.0; k < maxiter; k++ {
+		term := c * complex(HurwitzZeta(s-k, a), 0)
+		res += term
+		// HurwitzZeta(s-k, a) can vanish for alternate k, e.g. when a = 1 or 1/2 and s is an
+		// integer, so two consecutive terms are tested.
+		t := cmplx.Abs(term)
+		abs += t
+		if k > -s && math.Max(t, prev) < tol*cmplx.Abs(res) {
+			break
+		}
+		prev = t
+		c *= mu / complex(k+1, 0)
+	}
+	if abs > maxcancel*cmplx.Abs(res) {
+		return math.NaN()
+	}
+	return real(cmplx.Exp(complex(-a, 0)*mu) * res)
+}
+
+// lerch_phi_transform returns LerchPhi(z, s, a) for -1 < z < 0 and s < 0 using Lerch's
+// transformation formula
+//
+//	                    2 Gamma(σ)                                       ∞
+//	LerchPhi(z, s, a) = ---------- Re[Exp(iπσ/2 - aμ) λ**(-σ) ∑ Exp(-2πika) (1+k/λ)**(-σ)]
+//	                     (2πλ)**σ                                       k=0
+//
+// for 0 < a ≤ 1, where σ = 1-s, μ = Log(z) = Log(-z) + iπ and λ = μ / 2πi, and the
+// recurrence LerchPhi(z, s, a) = z**(-1) (LerchPhi(z, s, a-1) - (a-1)**(-s)) for a > 1.
+// The series converges rapidly for large σ, since |1+k/λ| ≥ 1+k/|λ|.
+//
+// See 25.14.2, Digital Library of Mathematical Functions (https://dlmf.nist.gov/25.14), and
+// M. Lerch. Note sur la fonction K(w, x, s). Acta Mathematica 11, 19-24 (1887).
+func lerch_phi_transform(z, s, a float64) float64 {
+	const (
+		maxiter = 10000
+		tol     = 1e-17
+	)
+
+	m := math.Ceil(a) - 1
+	a -= m
+
+	lz := math.Log(-z)
+	lam := complex(0.5, -lz/(2*math.Pi))
+	sigma := 1 - s
+
+	sum := complex(0, 0)
+	for k := 0.0; k < maxiter; k++ {
+		term := complex(cosPi(2*k*a), -sinPi(2*k*a)) * cmplx.Pow(1+complex(k, 0)/lam, complex(-sigma, 0))
+		sum += term
+		if k > 0 && cmplx.Abs(term) < tol*cmplx.Abs(sum) {
+			break
+		}
+	}
+
+	// The modulus and phase of 2 Gamma(σ) Exp(iπσ/2 - aμ) / (2πλ)**σ.
+	lg, _ := math.Lgamma(sigma)
+	r := math.Exp(math.Ln2 + lg - sigma*math.Log(2*math.Pi*cmplx.Abs(lam)) - a*lz)
+	t := sigma/2 - a - sigma*cmplx.Phase(lam)/math.Pi
+	res := r * (cosPi(t)*real(sum) - sinPi(t)*imag(sum))
+
+	for j := 0.0; j < m; j++ {
+		res = (res - math.Pow(a+j, -s)) / z
+	}
+	return res
+}
+
+// lerch_phi_euler_maclaurin returns LerchPhi(z, s, a) for 1/2 < z < 1 using the
+// Euler-Maclaurin summation formula
+//
+//	                    N-1                     ∞
+//	LerchPhi(z, s, a) =  ∑ z**k (k+a)**(-s) + ∫ dt f(t) + f(N) / 2 - ∑ T[k]
+//	                    k=0                    t=N                     k
+//
+// where f(t) = z**t (t+a)**(-s), T[k] = B(2k) / (2k)! f'(N), f' is the (2k-1)th derivative
+// of f and B(n) is the nth Bernoulli number. The integral is
+//
+//	 ∞
+//	 ∫ dt f(t) = z**N x**(1-s) Exp(y) En(s, y)
+//	t=N
+//
+// where x = a+N, y = -x Log(z) and En is the generalised exponential integral.
+func lerch_phi_euler_maclaurin(z, s, a float64) float64 {
+	const tol = 1e-17
+
+	n := math.Max(0, math.Ceil((math.Abs(s)+40)/math.Pi-a))
+	l := math.Log(z)
+
+	res := 0.0
+	for k := 0.0; k < n; k++ {
+		res += math.Exp(k*l - s*math.Log(k+a))
+	}
+
+	// The remainder, scaled by z**N x**(-s).
+	x := a + n
+	sum := x*lerch_phi_en_scaled(s, -x*l) + 0.5
+	for k, b := range bernoulli_coefficients {
+		// The (2k+1)th derivative of Exp(lt) (t+x)**(-s) at t = 0, scaled by x**(-s), is
+		// ∑ C(2k+1, i) l**(2k+1-i) (-1)**i Poch(s, i) x**(-i), summed over i.
+		m := 2*k + 1
+		d := 0.0
+		c := 1.0 // C(m, i) (-1)**i Poch(s, i) x**(-i)
+		for i := 0; i <= m; i++ {
+			d += c * math.Pow(l, float64(m-i))
+			c *= -float64(m-i) / float64(i+1) * (s + float64(i)) / x
+		}
+		term := b * d
+		sum -= term
+		if math.Abs(term) < tol*math.Abs(sum) {
+			break
+		}
+	}
+	return res + sum*math.Exp(n*l-s*math.Log(x))
+}
+
+// lerch_phi_en_scaled returns Exp(y) En(s, y) for real s and y > 0, where
+//
+//	             ∞
+//	En(s, y) = ∫ dt Exp(-yt) / t**s
+//	            t=1
+//
+// is the generalised exponential integral. For y ≤ 1 it uses the series
+//
+//	                                        ∞
+//	En(s, y) = Gamma(1-s) y**(s-1) - ∑ (-y)**n / (n! (1-s+n))
+//	                                       n=0
+//
+// and for y > 1 the continued fraction for GammaIncU(1-s, y) = y**(1-s) En(s, y). See 8.19.10,
+// Digital Library of Mathematical Functions (https://dlmf.nist.gov/8.19).
+//
+// For s = m+ε close to an integer m ≥ 1, the pole of Gamma(1-s) cancels that of the term with
+// n = m-1, and the sum of the two is computed as -(-y)**(m-1) / (m-1)! Expm1(g) / ε, where
+//
+//	                                   m-1
+//	g = Log(Gamma(1-ε)) + ε Log(y) - ∑ Log(1+ε/i)
+//	                                  i=1
+//
+// with the limit (-y)**(m-1) / (m-1)! (Digamma(m) - Log(y)) for ε = 0.
+func lerch_phi_en_scaled(s, y float64) float64 {
+	const (
+		maxiter = 200
+		tol     = 1e-17
+		epsmax  = 0.1
+	)
+
+	if y > 1 {
+		return 1 / gammaU_cf(1-s, y)
+	}
+
+	m := math.Round(s)
+	eps := s - m
+	n := -1.0
+	res := 0.0
+	if m >= 1 && math.Abs(eps) < epsmax {
+		n = m - 1
+	} else {
+		res = math.Gamma(1-s) * math.Pow(y, s-1)
+	}
+
+	c := 1.0 // (-y)**k / k!
+	for k := 0.0; k < maxiter; k++ {
+		var term float64
+		switch {
+		case k == n && eps == 0:
+			term = c * (Digamma(s) - math.Log(y))
+		case k == n:
+			term = -c * math.Expm1(lerch_phi_en_g(m, eps, y)) / eps
+		default:
+			term = -c / (1 - s + k)
+		}
+		res += term
+		if k > n && math.Abs(term) < tol*math.Abs(res) {
+			break
+		}
+		c *= -y / (k + 1)
+	}
+	return math.Exp(y) * res
+}
+
+// lerch_phi_en_g returns g of lerch_phi_en_scaled for integer m ≥ 1 and small ε ≠ 0, using
+// the series
+//
+//	                                        ∞
+//	Log(Gamma(1-ε)) = ε EulerGamma + ∑ Zeta(k) ε**k / k
+//	                                       k=2
+//
+// so that g is accurate relative to ε.
+func lerch_phi_en_g(m, eps, y float64) float64 {
+	const (
+		maxiter = 100
+		tol     = 1e-17
+	)
+
+	res := eps * (EulerGamma + math.Log(y))
+	for i := 1.0; i < m; i++ {
+		res -= math.Log1p(eps / i)
+	}
+	p := eps // ε**k
+	for k := 2.0; k < maxiter; k++ {
+		p *= eps
+		term := Zeta(k) * p / k
+		res += term
+		if math.Abs(term) < tol*math.Abs(res) {
+			break
+		}
+	}
+	return res
+}
@@ -0,0 +1,65 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestLerchPhi(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, Out float64
+	}{
+		{nan, 2, 1, nan},
+		{0.5, nan, 1, nan},
+		{0.5, 2, nan, nan},
+		{1.5, 2, 1, nan},
+		{0.5, 2, 0, nan},
+		{0.5, -inf, 1, nan},
+		{0.5, 0, 3, 2},
+		{0.5, 2, inf, 0},
+		{0, 2, 0.5, 4},
+		{0.5, inf, 0.5, inf},
+		{0.5, inf, 1, 1},
+		{0.5, inf, 2, 0},
+		{1, 2, 1, 1.6449340668482264},
+		{1, 0.5, 1, inf},
+		{-1, 2, 1, 0.8224670334241132},
+		{-1, -1.5, 1, 0.11868087071984022},
+		{-1, -3, 0.3, 0.071},
+		{0.3, 2, 0.5, 4.1504400857122965},
+		{0.5, -3.5, 2, 240.12044958794053},
+		{0.7, 2.5, 0.3, 20.738963876502655},
+		{0.9, 1, 7.5, 0.7660807680631354},
+		{0.95, 3, 20, 0.0008032799737930472},
+		{0.6, -10, 1.5, 12633879880.495605},
+		{0.6, -0.7, 100, 63.452671209744906},
+		{0.99, 1.5, 0.1, 33.71562420215688},
+		{0.99, 2.0000001, 1, 1.6046720891427164},
+		{0.999, 1, 2, 5.920590539470538},
+		{-0.4, 1.5, 0.7, 1.5560172346164027},
+		{-0.7, 0.5, 2.5, 0.4001279668129179},
+		{-0.9, 2, 5, 0.02484280718938783},
+		{-0.99, -8, 1, -0.078662110822051282},
+		{-0.99, -15, 0.05, 28433.269493414125},
+		{-0.95, -8, 0.5, 2.7421546563352899},
+		{-0.9, -10.5, 1, -19.61378512530581},
+		{-0.9, -15, 3.7, 3318092.1057715327},
+		{-0.7, -15, 0.5, -30455.67688062213},
+		{-0.7, -5.5, 0.05, -0.32835832738306131},
+		{-0.5, -8, 1, -4.0512117055326931},
+		{-0.5, -10.5, 0.5, 6.9065825577131346},
+		{-0.1, -15, 0.5, 1887.016167184331},
+		{-0.2, -40.5, 1, -1.232954894828349e+25},
+		{-0.01, -40.5, 0.3, 1.490031889290631e+18},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := LerchPhi(c.In1, c.In2, c.In3)
+			if !equalFloat64(res, c.Out) {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
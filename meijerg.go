@@ -0,0 +1,169 @@
+package special
+
+import "math"
+
+// MeijerG returns the Meijer G-function, defined by
+//
+//	                                           m                 n
+//	                         1                 ∏ Gamma(b[j]-s)   ∏ Gamma(1-a[j]+s)
+//	G(an, ap, bm, bq, x) = ---- ∫ ds x**s ---------------------------------------------
+//	                       2πi  L          q                   p
+//	                                       ∏ Gamma(1-b[j]+s)   ∏ Gamma(a[j]-s)
+//	                                     j=m+1               j=n+1
+//
+// where an = {a[1], ..., a[n]}, ap = {a[n+1], ..., a[p]}, bm = {b[1], ..., b[m]} and
+// bq = {b[m+1], ..., b[q]}, and the products in the numerator start from j=1. MeijerG is
+// evaluated for x > 0 as a sum of hypergeometric functions using Slater's theorem,
+//
+//	                        m
+//	G(an, ap, bm, bq, x) =  ∑ A[k] x**b[k] pFq-1(1+b[k]-a; 1+b[k]-b'; (-1)**(p-m-n) x)
+//	                       k=1
+//
+// where b' is b without b[k] and
+//
+//	          m                     n                      q                    p
+//	A[k] =    ∏  Gamma(b[j]-b[k])   ∏ Gamma(1+b[k]-a[j]) / ∏ Gamma(1+b[k]-b[j]) ∏ Gamma(a[j]-b[k])
+//	       j=1,j≠k                 j=1                   j=m+1                j=n+1
+//
+// which is valid for p < q, and for p = q and x < 1. Otherwise, the symmetry
+//
+//	G(an, ap, bm, bq, x) = G(1-bm, 1-bq, 1-an, 1-ap, 1/x)
+//
+// is used first. For p < q and x > 1, the terms of the sum grow and may cancel, e.g. when
+// MeijerG decreases exponentially as x → ∞. If m = q and n > 0, the symmetry then gives the
+// asymptotic expansion of MeijerG, whose divergent hypergeometric series are summed as in
+// HypPFQErr.
+//
+// The result is NaN if two elements of bm differ by an integer, or if a[j]-b[k] is a positive
+// integer for some j ≤ n and k ≤ m, since then the sum over k is degenerate. It is also NaN if
+// the estimated relative error of the sum exceeds 1e-8.
+//
+// See 16.17.2, 16.19.1 and 16.11, Digital Library of Mathematical Functions
+// (https://dlmf.nist.gov/16.17).
+func MeijerG(an, ap, bm, bq []float64, x float64) float64 {
+	const tol = 1e-8
+
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || x <= 0 || math.IsInf(x, 1):
+		return math.NaN()
+	}
+
+	m := len(bm)
+	n := len(an)
+	p := n + len(ap)
+	q := m + len(bq)
+
+	var res, err float64
+	switch {
+	case p > q || p == q && x > 1:
+		res, err = meijer_g_slater(meijer_g_reflect(bm), meijer_g_reflect(bq), meijer_g_reflect(an), meijer_g_reflect(ap), 1/x, false)
+		// For p = q, the sum for x < 1 continues analytically to x > 1, unless it has a
+		// branch point at x = 1.
+		if math.IsNaN(res) && p == q && (p-n-m)&1 == 1 {
+			res, err = meijer_g_slater(an, ap, bm, bq, x, false)
+		}
+	default:
+		res, err = meijer_g_slater(an, ap, bm, bq, x, false)
+		if p < q && x > 1 && m == q && n > 0 && !(err <= tol*math.Abs(res)) {
+			r, e := meijer_g_slater(meijer_g_reflect(bm), meijer_g_reflect(bq), meijer_g_reflect(an), meijer_g_reflect(ap), 1/x, true)
+			if e <= tol*math.Abs(r) {
+				res, err = r, e
+			}
+		}
+	}
+	if !(err <= tol*math.Abs(res)) {
+		return math.NaN()
+	}
+	return res
+}
+
+// meijer_g_reflect returns 1-a for each element of a.
+func meijer_g_reflect(a []float64) []float64 {
+	res := make([]float64, len(a))
+	for i, ai := range a {
+		res[i] = 1 - ai
+	}
+	return res
+}
+
+// meijer_g_slater returns MeijerG(an, ap, bm, bq, x) as the sum of Slater's theorem, and an
+// estimate of its absolute error. The result is NaN if the parameters are degenerate. The
+// factors 1 / Gamma(1+b[k]-b[j]) are combined with the hypergeometric functions using
+// HypPFQRegularized, and, for j ≤ m, with Gamma(b[j]-b[k]) using the reflection formula
+// Gamma(z) Gamma(1-z) = π / Sin(πz). If sumdiv is true, the divergent hypergeometric series
+// are summed as in HypPFQErr.
+func meijer_g_slater(an, ap, bm, bq []float64, x float64, sumdiv bool) (float64, float64) {
+	m := len(bm)
+	n := len(an)
+	p := n + len(ap)
+	q := m + len(bq)
+
+	a := append(append([]float64{}, an...), ap...)
+	b := append(append([]float64{}, bm...), bq...)
+	for _, v := range append(append([]float64{}, a...), b...) {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return math.NaN(), math.NaN()
+		}
+	}
+
+	// Check that the poles of Gamma(b[k]-s) are simple and distinct from those of Gamma(1-a[j]+s).
+	for k, bk := range bm {
+		for j := k + 1; j < m; j++ {
+			if d := bm[j] - bk; d == math.Trunc(d) {
+				return math.NaN(), math.NaN()
+			}
+		}
+		for _, aj := range an {
+			if isNonPosInt(1 + bk - aj) {
+				return math.NaN(), math.NaN()
+			}
+		}
+	}
+
+	y := x
+	if (p-m-n)&1 == 1 {
+		y = -x
+	}
+
+	res := 0.0
+	err := 0.0
+	for k, bk := range bm {
+		// The numerator and denominator parameters of the hypergeometric function.
+		num := make([]float64, p)
+		for j, aj := range a {
+			num[j] = 1 + bk - aj
+		}
+		den := make([]float64, 0, q-1)
+		for j, bj := range b {
+			if j != k {
+				den = append(den, 1+bk-bj)
+			}
+		}
+
+		// The Gamma functions in A[k] other than 1 / Gamma(1+b[k]-b[j]), and their
+		// relative error.
+		s := 1.0
+		es := hyp_eps * (1 + math.Abs(bk*math.Log(x)))
+		for j := 0; j < m; j++ {
+			if j != k {
+				s *= math.Pi / sinPi(bm[j]-bk)
+				es += 2 * hyp_eps
+			}
+		}
+		ga := make([]float64, 0, p-n)
+		for _, aj := range ap {
+			ga = append(ga, aj-bk)
+		}
+		g, eg := hyp_gamma_ratio(num[:n], ga)
+		s *= g * math.Pow(x, bk)
+		if g != 0 {
+			es += eg / math.Abs(g)
+		}
+
+		f, ef := hyp_pfq_regularized(num, den, y, sumdiv)
+		res += s * f
+		err += math.Abs(s)*ef + es*math.Abs(s*f)
+	}
+	return res, err + hyp_eps*math.Abs(res)
+}
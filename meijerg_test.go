@@ -0,0 +1,46 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestMeijerG(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, In4 []float64
+		In5, Out           float64
+	}{
+		{[]float64{}, []float64{}, []float64{0}, []float64{}, nan, nan},
+		{[]float64{}, []float64{}, []float64{0}, []float64{}, -1, nan},
+		{[]float64{}, []float64{}, []float64{nan}, []float64{}, 1, nan},
+		{[]float64{}, []float64{}, []float64{0, 1}, []float64{}, 1, nan},
+		{[]float64{1.5}, []float64{}, []float64{0.5}, []float64{}, 1, nan},
+		{[]float64{}, []float64{}, []float64{}, []float64{0}, 1, 0},
+		{[]float64{}, []float64{}, []float64{0}, []float64{}, 1.5, 0.22313016014842982},
+		{[]float64{1}, []float64{}, []float64{}, []float64{}, 2, 0.6065306597126334},
+		{[]float64{0.3}, []float64{}, []float64{0.5}, []float64{}, 0.5, 0.3991149493344985},
+		{[]float64{0.3}, []float64{}, []float64{0.5}, []float64{}, 3, 0.30130833221501635},
+		{[]float64{}, []float64{}, []float64{0.25, -0.25}, []float64{}, 1, 0.2398755439361229},
+		{[]float64{}, []float64{}, []float64{0.25, -0.25}, []float64{}, 4, 0.022955249153216107},
+		{[]float64{}, []float64{}, []float64{0.5}, []float64{0}, 2, 0.17381086802663795},
+		{[]float64{1, 1}, []float64{}, []float64{1}, []float64{0}, 0.5, 0.4054651081081644},
+		{[]float64{1, 1}, []float64{}, []float64{1}, []float64{0}, 2, 1.0986122886681098},
+		{[]float64{}, []float64{1.5}, []float64{0.5}, []float64{}, 0.25, 0.5},
+		{[]float64{}, []float64{1.5}, []float64{0.5}, []float64{}, 2, 0},
+		{[]float64{0.3}, []float64{}, []float64{0, 0.55}, []float64{}, 30, 0.10581159626191339},
+		{[]float64{0.3}, []float64{}, []float64{0, 0.55}, []float64{}, 1e4, 0.001864559704062883},
+		{[]float64{}, []float64{}, []float64{0.25, 0}, []float64{}, 10, 0.0023488718076910115},
+		{[]float64{}, []float64{}, []float64{0.25, 0}, []float64{}, 30, nan},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := MeijerG(c.In1, c.In2, c.In3, c.In4, c.In5)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
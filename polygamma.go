@@ -47,24 +47,13 @@ func Polygamma(n int, x float64) float64 {
 			reflect = true
 		}
 
-		pg := 0.0
-		xsmall := 2 * math.Sqrt(float64(n))
-		if n >= 10 && x < xsmall {
-			pg = polygammanseries(n, lnfac, x)
-		} else {
-			// Recurrence formula, where pg(n, x) = polygamma(n, x):
-			// pg(n, x) = pg(n, x+1) - (-1)**n n! / x**(n+1)
-			shift := 0.0
-			xlarge := math.Min(float64(n), 10)
-			for x < math.Max(2*xsmall, xlarge) {
-				shift -= math.Exp(lnfac - float64(n+1)*math.Log(x))
-				x++
-			}
-			pg = polygamman(n, lnfac, x) + float64(s)*shift
-		}
+		// Polygamma(n, x) = (-1)**(n+1) n! HurwitzZeta(n+1, x), where the scaled Hurwitz zeta
+		// function is used to avoid overflow for small x.
+		m := float64(n + 1)
+		pg := -s * math.Exp(lnfac-m*math.Log(x)) * hurwitz_zeta_scaled(m, x, hurwitz_zeta_n(m, x))
 
 		if reflect {
-			return float64(s)*pg - c
+			return s*pg - c
 		}
 		return pg
 	}
@@ -112,80 +101,6 @@ func scaledcotderiv(n int, x float64) float64 {
 	return float64(s) * res
 }
 
-// polygamman returns formula 6.4.11, p.260 from Ambramowitz & Stegun
-func polygamman(n int, lnfac, x float64) float64 {
-	// Coefficients for asymptotic expansion:
-	// b_{2k} = BernoulliB_{2k}/(2k)!
-	const (
-		b2  = 1. / 12
-		b4  = -1. / 720
-		b6  = 1. / 30240
-		b8  = -1. / 1209600
-		b10 = 1. / 47900160
-		b12 = -691. / 1307674368000
-		b14 = 1. / 74724249600
-		b16 = -3617. / 10670622842880000
-		b18 = 43867. / 5109094217170944000
-		b20 = -174611. / 802857662698291200000
-		b22 = 77683. / 14101100039391805440000
-		b24 = -236364091. / 1693824136731743669452800000
-		b26 = 657931. / 186134520519971831808000000
-		b28 = -3392780147. / 37893265687455865519472640000000
-		/*
-			b30 = 1723168255201. / 759790291646040068357842010112000000
-			b32 = -7709321041217. / 134196726836183700385281186201600000000
-			b34 = 151628697551. / 104199811425742637946218332815360000000
-			b36 = -26315271553053477373. / 713925872841910517552409860896601407488000000000
-			b38 = 154210205991661. / 165165037094716140555791754978970828800000000
-			b40 = -261082718496449122051. / 11039333782344056345696120477635448049500160000000000
-		*/
-	)
-
-	s := powN1(n + 1)
-
-	y := 1 / (x * x)
-	lx := math.Log(x)
-
-	return float64(s) * math.Exp(lnfac-float64(n)*lx) * (1/float64(n) + 1/x*(0.5+
-		float64(n+1)/x*(b2+
-			float64((n+2)*(n+3))*y*(b4+
-				float64((n+4)*(n+5))*y*(b6+
-					float64((n+6)*(n+7))*y*(b8+
-						float64((n+8)*(n+9))*y*(b10+
-							float64((n+10)*(n+11))*y*(b12+
-								float64((n+12)*(n+13))*y*(b14+
-									float64((n+14)*(n+15))*y*(b16+
-										float64((n+16)*(n+17))*y*(b18+
-											float64((n+18)*(n+19))*y*(b20+
-												float64((n+20)*(n+21))*y*(b22+
-													float64((n+22)*(n+23))*y*(b24+
-														float64((n+24)*(n+25))*y*(b26+
-															float64((n+26)*(n+27))*y*b28)))))))))))))))
-}
-
-// polygammanseries returns polygamma(n, x) using the series definition
-//
-//	                     ∞
-//	polygamma(n, x) = n! ∑ (k+x)**(-n-1)
-//	                    k=0
-func polygammanseries(n int, lnfac, x float64) float64 {
-	const (
-		maxiter = 200
-		tol     = 1e-16
-	)
-
-	s := powN1(n + 1)
-
-	res := 0.0
-	tmp := 1.0
-	for k := 0; k <= maxiter && math.Abs(tmp/res) > tol; k++ {
-		kx := float64(k) + x
-		tmp = math.Exp(lnfac - float64(n+1)*math.Log(kx))
-		res += tmp
-	}
-	return float64(s) * res
-}
-
 func polygamma2(x float64) float64 {
 	const xmin = 7
 
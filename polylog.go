@@ -0,0 +1,358 @@
+package special
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Polylog returns the polylogarithm of order s and real argument x, defined by
+//
+//	               ∞
+//	Polylog(s, x) = ∑ x**k / k**s
+//	              k=1
+//
+// for |x| ≤ 1, and by analytic continuation elsewhere. For x > 1, where the polylogarithm
+// is complex, Polylog returns the real part, which is the same on both sides of the branch
+// cut. In particular, Polylog(1, x) = -Log(1-x), Polylog(2, x) is the dilogarithm (Spence's
+// function) and Polylog(s, 1) = Zeta(s) for s > 1.
+//
+// Like PolylogComplex, Polylog is NaN for large non-integer s and x > 1 where it cannot be
+// computed accurately.
+//
+// See http://mathworld.wolfram.com/Polylogarithm.html for more information.
+func Polylog(s, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(s) || math.IsNaN(x) || math.IsInf(s, -1):
+		return math.NaN()
+	case x == 0:
+		return 0
+	case math.IsInf(s, 1):
+		if math.Abs(x) < 1 {
+			return x
+		}
+		return math.NaN()
+	case math.IsInf(x, 0):
+		switch {
+		case s > 0:
+			return math.Inf(-1)
+		case s == 0:
+			return -1
+		}
+		return 0
+	case x == 1:
+		if s > 1 {
+			return Zeta(s)
+		}
+		return math.Inf(1)
+	case s == 0:
+		return x / (1 - x)
+	case s == 1:
+		if x > 1 {
+			return -math.Log(x - 1)
+		}
+		return -math.Log1p(-x)
+	case s == 2:
+		return polylog2(x)
+	case s == 3:
+		return polylog3(x)
+	}
+	return real(PolylogComplex(s, complex(x, 0)))
+}
+
+// PolylogComplex returns the polylogarithm of real order s and complex argument z, defined by
+//
+//	                      ∞
+//	PolylogComplex(s, z) = ∑ z**k / k**s
+//	                     k=1
+//
+// for |z| ≤ 1, and by analytic continuation elsewhere. PolylogComplex has a branch cut along
+// the real axis for z > 1, and is continuous onto the cut from above, with the sign of the
+// imaginary part following the sign of Im(z). For non-integer s > 1 and |Log(z)| ≥ 4.5, the
+// result loses accuracy as s and |z| grow, and is NaN once its estimated relative error exceeds
+// 1e-8, except for real z < -1, where PolylogComplex(s, z) = -FermiDirac(s-1, Log(-z)).
+//
+// See http://mathworld.wolfram.com/Polylogarithm.html for more information.
+func PolylogComplex(s float64, z complex128) complex128 {
+	x, y := real(z), imag(z)
+
+	// Special cases.
+	switch {
+	case math.IsNaN(s) || math.IsNaN(x) || math.IsNaN(y) || math.IsInf(s, 0):
+		return cmplx.NaN()
+	case math.IsInf(x, 0) || math.IsInf(y, 0):
+		return cmplx.Inf()
+	case z == 0:
+		return 0
+	case z == 1:
+		if s > 1 {
+			return complex(Zeta(s), 0)
+		}
+		return cmplx.Inf()
+	case s == 0:
+		return z / (1 - z)
+	case s == 1:
+		return -cmplx.Log(1 - z)
+	}
+
+	// The series in Log(z) converges for |Log(z)| < 2π, and is used wherever it converges
+	// reasonably quickly, except for small |z| and positive s, where the defining series is
+	// faster. For negative s, the terms of the defining series grow and alternate in sign
+	// unless z > 0.
+	const mumax = 4.5
+
+	mu := cmplx.Log(z)
+	switch {
+	case cmplx.Abs(z) <= 0.5 && (s > 0 || cmplx.Abs(mu) >= mumax):
+		return polylog_series(s, z)
+	case cmplx.Abs(mu) < mumax:
+		return polylog_log_series(s, mu)
+	case y == 0 && x < -1 && s > 1 && s != math.Trunc(s):
+		// The Fermi-Dirac integral, unlike the inversion formula, is accurate for large s.
+		return complex(-FermiDirac(s-1, math.Log(-x)), 0)
+	}
+	return polylog_inversion(s, z)
+}
+
+// polylog_series returns PolylogComplex(s, z) for |z| < 1 by summing the defining series.
+func polylog_series(s float64, z complex128) complex128 {
+	const (
+		maxiter = 1000
+		tol     = 1e-17
+	)
+
+	var res complex128
+	zk := z
+	for k := 1.0; k < maxiter; k++ {
+		term := zk * complex(math.Pow(k, -s), 0)
+		res += term
+		if cmplx.Abs(term) < tol*cmplx.Abs(res) && k > s {
+			break
+		}
+		zk *= z
+	}
+	return res
+}
+
+// polylog_log_series returns PolylogComplex(s, z) for |μ| < 2π, where μ = Log(z), using the
+// series
+//
+//	                                          ∞
+//	PolylogComplex(s, z) = Gamma(1-s) (-μ)**(s-1) + ∑ Zeta(s-k) μ**k / k!
+//	                                         k=0
+//
+// for non-integer s, and its limit
+//
+//	                                                                 ∞
+//	PolylogComplex(n, z) = μ**(n-1) / (n-1)! (Harmonic(n-1) - Log(-μ)) + ∑ Zeta(n-k) μ**k / k!
+//	                                                               k≠n-1
+//
+// for positive integer n. See 25.12.12, Digital Library of Mathematical Functions
+// (https://dlmf.nist.gov/25.12).
+func polylog_log_series(s float64, mu complex128) complex128 {
+	const (
+		maxiter = 200
+		tol     = 1e-17
+	)
+
+	n := -1.0
+	var res complex128
+	if s > 0 && s == math.Trunc(s) {
+		n = s - 1
+	} else {
+		res = complex(math.Gamma(1-s), 0) * cmplx.Pow(-mu, complex(s-1, 0))
+	}
+
+	c := complex(1, 0) // μ**k / k!
+	for k := 0.0; k < maxiter; k++ {
+		var term complex128
+		if k == n {
+			term = c * (complex(Harmonic(n), 0) - cmplx.Log(-mu))
+		} else {
+			term = c * complex(Zeta(s-k), 0)
+		}
+		res += term
+		// Zeta(s-k) vanishes at the negative even integers, so only non-zero terms are tested.
+		if k > s && term != 0 && cmplx.Abs(term) < tol*cmplx.Abs(res) {
+			break
+		}
+		c *= mu / complex(k+1, 0)
+	}
+	return res
+}
+
+// polylog_inversion returns PolylogComplex(s, z) for |z| ≥ 1. For integer s = n ≥ 2, it uses
+// the inversion formula
+//
+//	                                                             n
+//	PolylogComplex(n, z) = -(-1)**n PolylogComplex(n, 1/z) - ∑ c[k] (iπ + L)**(n-k) / (n-k)!
+//	                                                            k=0
+//
+// where L = Log(-z), c[0] = 1, c[1] = -iπ, c[k] = -2 Zeta(k) for even k and c[k] = 0 for odd
+// k > 1. For integer s = -n < 0, PolylogComplex(s, z) = (-1)**(n+1) PolylogComplex(s, 1/z). For
+// non-integer s, it uses
+//
+//	PolylogComplex(s, z) = Gamma(1-s) / (2π)**(1-s) [i**(1-s) HurwitzZeta(1-s, 1/2 + L/2πi) +
+//	                                                 i**(s-1) HurwitzZeta(1-s, 1/2 - L/2πi)]
+//
+// See 25.12.13, Digital Library of Mathematical Functions (https://dlmf.nist.gov/25.12). For
+// non-integer s > 1, HurwitzZeta(1-s, a) is small compared to the terms of its Euler-Maclaurin
+// sum, and the relative error grows with s and |L|, to about 1e-12 for s = 5 and 1e-7 for
+// s = 10 when |z| = 100. The result is NaN if this error, estimated from the terms of the sums,
+// exceeds 1e-8.
+func polylog_inversion(s float64, z complex128) complex128 {
+	const tol = 1e-8
+
+	l := cmplx.Log(-z)
+
+	if s != math.Trunc(s) {
+		w := l / complex(0, 2*math.Pi)
+		p := cmplx.Rect(1, math.Pi*(1-s)/2) // i**(1-s)
+		f := math.Gamma(1-s) * math.Pow(2*math.Pi, s-1)
+		h1, abs1 := hurwitz_zeta_complex(1-s, 0.5+w)
+		h2, abs2 := hurwitz_zeta_complex(1-s, 0.5-w)
+		res := complex(f, 0) * (p*h1 + cmplx.Conj(p)*h2)
+		if hyp_eps*math.Abs(f)*(abs1+abs2) > tol*cmplx.Abs(res) {
+			return cmplx.NaN()
+		}
+		return res
+	}
+
+	res := PolylogComplex(s, 1/z)
+	if s < 0 {
+		if math.Mod(s, 2) == 0 {
+			return -res
+		}
+		return res
+	}
+	if math.Mod(s, 2) == 0 {
+		res = -res
+	}
+
+	// sum = ∑ c[k] u**(n-k) / (n-k)!, accumulated from k = n down to k = 0.
+	u := complex(0, math.Pi) + l
+	var sum complex128
+	c := complex(1, 0) // u**(n-k) / (n-k)!
+	for k := s; k >= 0; k-- {
+		switch {
+		case k == 0:
+			sum += c
+		case k == 1:
+			sum -= complex(0, math.Pi) * c
+		case math.Mod(k, 2) == 0:
+			sum -= complex(2*Zeta(k), 0) * c
+		}
+		c *= u / complex(s-k+1, 0)
+	}
+	return res - sum
+}
+
+// polylog2 returns the dilogarithm Polylog(2, x) for real x, using the reflection formula
+//
+//	Polylog(2, x) = π**2/6 - Log(x) Log(1-x) - Polylog(2, 1-x)
+//
+// for 1/2 < x < 1, and the inversion formula
+//
+//	Polylog(2, x) = -π**2/6 - Log(-x)**2 / 2 - Polylog(2, 1/x)
+//
+// (the real part of which holds for x > 1 with Log(-x) = Log(x) - iπ) for |x| > 1, to reduce x
+// to [-1, 1/2]. There, it uses the series in u = -Log(1-x)
+//
+//	                                ∞
+//	Polylog(2, x) = u - u**2 / 4 + ∑ B(2k) u**(2k+1) / (2k+1)!
+//	                               k=1
+//
+// where B(n) is the nth Bernoulli number, which converges rapidly for |u| ≤ Log(2).
+func polylog2(x float64) float64 {
+	const (
+		pi2 = math.Pi * math.Pi
+		tol = 1e-17
+	)
+
+	switch {
+	case x < -1:
+		l := math.Log(-x)
+		return -pi2/6 - l*l/2 - polylog2(1/x)
+	case x > 1:
+		l := math.Log(x)
+		return pi2/3 - l*l/2 - polylog2(1/x)
+	case x > 0.5:
+		return pi2/6 - math.Log(x)*math.Log1p(-x) - polylog2(1-x)
+	}
+
+	u := -math.Log1p(-x)
+	u2 := u * u
+	res := u - u2/4
+	tmp := u * u2
+	for k, b := range bernoulli_coefficients {
+		term := b * tmp / float64(2*k+3)
+		res += term
+		if math.Abs(term) < tol*math.Abs(res) {
+			break
+		}
+		tmp *= u2
+	}
+	return res
+}
+
+// polylog3 returns the trilogarithm Polylog(3, x) for real x, using the inversion formulae
+//
+//	Polylog(3, x) = Polylog(3, 1/x) - π**2/6 Log(-x) - Log(-x)**3 / 6,   x < -1
+//	Polylog(3, x) = Polylog(3, 1/x) + π**2/3 Log(x) - Log(x)**3 / 6,     x > 1
+//
+// where the latter is the real part, and Landen's identity
+//
+//	Polylog(3, x) = Zeta(3) + Log(x)**3 / 6 + π**2/6 Log(x) - Log(x)**2 Log(1-x) / 2
+//	                - Polylog(3, 1-x) - Polylog(3, 1-1/x)
+//
+// for 1/2 < x < 1, to reduce x to [-1, 1/2]. There, it uses the series in u = -Log(1-x), with the
+// coefficients of polylog3_coefficients, which converges rapidly for |u| ≤ Log(2).
+func polylog3(x float64) float64 {
+	const (
+		pi2   = math.Pi * math.Pi
+		zeta3 = 1.202056903159594285399738161511449990764986292340498881792271555
+	)
+
+	switch {
+	case x < -1:
+		l := math.Log(-x)
+		return polylog3(1/x) - l*(pi2/6+l*l/6)
+	case x > 1:
+		l := math.Log(x)
+		return polylog3(1/x) + l*(pi2/3-l*l/6)
+	case x == 1:
+		return zeta3
+	case x > 0.5:
+		l := math.Log(x)
+		return zeta3 + l*(l*l/6+pi2/6-l*math.Log1p(-x)/2) - polylog3(1-x) - polylog3(1-1/x)
+	}
+
+	u := -math.Log1p(-x)
+	return u * poly(u, polylog3_coefficients...)
+}
+
+// The coefficients of the series of the trilogarithm in u = -Log(1-x) are
+// polylog3_coefficients[n] = 1 / (n+1) ∑ B(k) B(n-k) / ((k+1)! (n-k)!)
+// where the sum is over k = 0, ..., n and B(k) is the kth Bernoulli number, with B(1) = -1/2.
+var polylog3_coefficients = []float64{
+	1,
+	-3. / 8,
+	17. / 216,
+	-5. / 576,
+	7. / 54000,
+	7. / 86400,
+	-19. / 5556600,
+	-1.328656462585034e-06,
+	8.660871756109851e-08,
+	2.52608759553204e-08,
+	-2.144694468364065e-09,
+	-5.140110622012979e-10,
+	5.24958211460083e-11,
+	1.0887754406636318e-11,
+	-1.2779396094493695e-12,
+	-2.369824177308745e-13,
+	3.104357887965462e-14,
+	5.261758629912506e-15,
+	-7.538479549949265e-16,
+	-1.1862322577752286e-16,
+}
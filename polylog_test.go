@@ -0,0 +1,108 @@
+package special_test
+
+import (
+	"fmt"
+	"math/cmplx"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestPolylog(t *testing.T) {
+	cases := []struct {
+		In1, In2, Out float64
+	}{
+		{nan, 0.5, nan},
+		{2, nan, nan},
+		{-inf, 0.5, nan},
+		{2, 0, 0},
+		{inf, 0.5, 0.5},
+		{2, -inf, -inf},
+		{-1, inf, 0},
+		{2, 1, 1.6449340668482264},
+		{0.5, 1, inf},
+		{1, 0.5, 0.6931471805599453},
+		{1, 3, -0.6931471805599453},
+		{0, -3, -0.75},
+		{2, -1, -0.8224670334241132},
+		{2, 0.3, 0.3261295100754761},
+		{2, -0.7, -0.6051584023377052},
+		{2, 0.8, 1.0747946000082484},
+		{2, -5, -2.749279126060808},
+		{2, 1.5, 2.37439527027248},
+		{2, 3, 2.3201804233130985},
+		{3, 0.3, 0.3124001778928926},
+		{3, -0.9, -0.8186382015443638},
+		{3, 0.75, 0.8444258088622045},
+		{3, 1.7, 2.3625679325942115},
+		{3, 7, 5.319257992145675},
+		{3, -20, -9.45829664460035},
+		{4, -50, -24.219817263960145},
+		{5, 0.95, 0.9829575989510685},
+		{0.5, -0.6, -0.4278617886001514},
+		{0.5, 1.5, -1.5466407024391606},
+		{1.5, 0.99, 2.2716600770079993},
+		{1.5, -100, -7.889101914721549},
+		{2.5, 0.9, 1.1390030252021568},
+		{2.5, -3, -2.1627007120020565},
+		{2.5, 5, 3.5038581437010428},
+		{2.5, -1e8, -446.17520328952844},
+		{3.5, -300, -55.41767260890754},
+		{-0.5, -1e4, -0.18907878406678735},
+		{-1.5, -2.5, -0.01922109231880976},
+		{-2, -3, 0.09375},
+		{-3, 0.4, 8.51851851851852},
+		{-20, -0.3, 41295981.475349106},
+		{50, 0.9, 0.9000000000000007},
+		{10.5, -30, -29.513883420622552},
+		{10.5, -900, -761.42568094933199},
+		{20.5, -30, -29.999397260937279},
+		{20.5, -900, -899.51367801090851},
+		{15.5, 900, nan},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Polylog(c.In1, c.In2)
+			if !equalFloat64(res, c.Out) {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestPolylogComplex(t *testing.T) {
+	cases := []struct {
+		In1      float64
+		In2, Out complex128
+	}{
+		{nan, 0.5, cmplx.NaN()},
+		{2, cmplx.NaN(), cmplx.NaN()},
+		{2, 0, 0},
+		{2, 1, complex(1.6449340668482264, 0)},
+		{1, complex(0, 1), complex(-0.34657359027997264, 0.7853981633974483)},
+		{2, complex(3, 0), complex(2.3201804233130985, 3.4513922952232026)},
+		{2, complex(3, -1e-300), complex(2.3201804233130985, -3.4513922952232026)},
+		{2, complex(-0.3, 0.4), complex(-0.30749828803358165, 0.3460243065793686)},
+		{2, complex(0.9, 0.9), complex(0.6115102073124149, 1.3006011903521026)},
+		{3, complex(2, 3), complex(0.676600146851506, 3.4098814160096014)},
+		{4, complex(0, 1), complex(-0.05918955184357819, 0.9889445517411054)},
+		{-2, complex(2, 2), complex(0.336, 0.848)},
+		{-3, complex(2, 1), complex(-4, -7)},
+		{2.5, complex(0.5, 1.2), complex(0.21613111098985, 1.294504700986939)},
+		{-1.5, complex(-1.5, -0.5), complex(-0.07178824243547698, 0.03794014645098281)},
+		{0.3, complex(1.5, 0.001), complex(-2.397806267501207, 1.9770766577416985)},
+		{4.5, complex(10, -10), complex(4.80489699673682, -12.180424786789589)},
+		{5.5, complex(-30, 5), complex(-22.820073256554359, 3.1821397406454439)},
+		{20.5, complex(-900, 0), complex(-899.51367801090851, 0)},
+		{20.5, complex(0, 100), cmplx.NaN()},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := PolylogComplex(c.In1, c.In2)
+			ok := equalFloat64(real(res), real(c.Out)) && equalFloat64(imag(res), imag(c.Out))
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package special
+
+import "math"
+
+// RiccatiBesselS returns the Riccati-Bessel function of the first kind, defined by
+//
+//	RiccatiBesselS(n, x) = x SphericalBesselJ(n, x)
+//
+// where SphericalBesselJ is the spherical Bessel function of the first kind. For n < 0,
+// RiccatiBesselS(n, x) = (-1)**(n+1) RiccatiBesselC(-n-1, x).
+//
+// See http://mathworld.wolfram.com/Riccati-BesselFunctions.html for more information.
+func RiccatiBesselS(n int, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, 0):
+		return math.NaN()
+	case n < 0:
+		return float64(powN1(n+1)) * RiccatiBesselC(-n-1, x)
+	case x == 0:
+		return 0
+	}
+	return x * SphericalBesselJ(n, x)
+}
+
+// RiccatiBesselC returns the Riccati-Bessel function of the second kind, defined by
+//
+//	RiccatiBesselC(n, x) = -x SphericalBesselY(n, x)
+//
+// where SphericalBesselY is the spherical Bessel function of the second kind. For n < 0,
+// RiccatiBesselC(n, x) = (-1)**n RiccatiBesselS(-n-1, x).
+//
+// See http://mathworld.wolfram.com/Riccati-BesselFunctions.html for more information.
+func RiccatiBesselC(n int, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, 0):
+		return math.NaN()
+	case n < 0:
+		return float64(powN1(n)) * RiccatiBesselS(-n-1, x)
+	case x == 0:
+		if n == 0 {
+			return 1
+		}
+		return math.Inf(1)
+	}
+	return -x * SphericalBesselY(n, x)
+}
@@ -0,0 +1,73 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestRiccatiBesselS(t *testing.T) {
+	cases := []struct {
+		In1      int
+		In2, Out float64
+	}{
+		{0, nan, nan},
+		{0, +inf, nan},
+		{3, 0, 0},
+		{-1, 0, 1},
+		{-1, 3.14, -0.99999873172753950},
+		{0, 0.001, 0.00099999983333334168},
+		{0, 3.14, 0.0015926529164869527},
+		{0, 50, -0.26237485370392882},
+		{1, 0.5, 0.08126851531803328},
+		{1, 10, 0.78466941798751544},
+		{5, 0.001, 9.6200092500092557e-23},
+		{5, 3.14, 0.062464055227770786},
+		{10, 10, 0.64605154492564265},
+		{10, 29, 0.49575979559458822},
+		{30, 0.5, 2.6077363040998515e-52},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := RiccatiBesselS(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestRiccatiBesselC(t *testing.T) {
+	cases := []struct {
+		In1      int
+		In2, Out float64
+	}{
+		{0, nan, nan},
+		{0, -inf, nan},
+		{0, 0, 1},
+		{2, 0, +inf},
+		{-1, 0, 0},
+		{-2, 0.5, 0.081268515318033281},
+		{0, 0.001, 0.99999950000004167},
+		{0, 3.14, -0.99999873172753961},
+		{0, 50, 0.96496602849211321},
+		{1, 0.5, 2.2345906623849485},
+		{1, 10, -0.62792826379701505},
+		{5, 0.001, 9.4500005250000179e+17},
+		{5, 3.14, 5.6934271252362416},
+		{10, 10, 1.7245367208805784},
+		{10, 29, -0.90919832177691351},
+		{30, 0.5, 3.1436553308254508e+49},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := RiccatiBesselC(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestRiemannSiegelTheta(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{inf, inf},
+		{-inf, -inf},
+		{0, 0},
+		{0.5, -1.125052715405563},
+		{1, -1.7675479528122904},
+		{10, -3.0670743962898954},
+		{17.8, -0.02376807510441117},
+		{-20, -1.1868948084444841},
+		{100, 87.97216523178722},
+		{1000, 2034.5464280380315},
+		{5000, 14197.897617602197},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := RiemannSiegelTheta(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestRiemannSiegelZ(t *testing.T) {
+	cases := []struct {
+		In, Out float64
+	}{
+		{nan, nan},
+		{inf, nan},
+		{0, -1.4603545088095868},
+		{1, -0.7363054628673177},
+		{10, -1.5491945461810224},
+		{18, 2.336799689916952},
+		{-50, -0.340735005955025},
+		{100, 2.6926970566644637},
+		{1000, 0.9977946375215866},
+		{2999.5, 0.02877914273404596},
+		{3001, 0.18181762600745208},
+		{5000, -0.8042572363529399},
+		{12345.6, -1.1342742831013375},
+
+		// Zeros on the critical line.
+		{14.134725141734695, 0},
+		{21.022039638771556, 0},
+		{25.01085758014569, 0},
+		{236.5242296658162, 0},
+		{1419.4224809459956, 0},
+		{5000.234316931328, 0},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := RiemannSiegelZ(c.In)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
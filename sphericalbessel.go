@@ -0,0 +1,192 @@
+package special
+
+import "math"
+
+// SphericalBesselJ returns the spherical Bessel function of the first kind, defined by
+//
+//	SphericalBesselJ(n, x) = Sqrt(π/(2x)) BesselJ(n+1/2, x)
+//
+// where BesselJ is the Bessel function of the first kind. For n < 0,
+// SphericalBesselJ(n, x) = (-1)**n SphericalBesselY(-n-1, x).
+//
+// See http://mathworld.wolfram.com/SphericalBesselFunctionoftheFirstKind.html for more information.
+func SphericalBesselJ(n int, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case n < 0:
+		return float64(powN1(n)) * SphericalBesselY(-n-1, x)
+	case math.IsInf(x, 0):
+		return 0
+	case x == 0:
+		if n == 0 {
+			return 1
+		}
+		return 0
+	case x < 0:
+		return float64(powN1(n)) * SphericalBesselJ(n, -x)
+	}
+
+	sin, cos := math.Sincos(x)
+	j0 := sin / x
+	if n == 0 {
+		return j0
+	}
+	j1 := (j0 - cos) / x
+
+	// Forward recurrence is stable for n < x.
+	if float64(n) < x {
+		for k := 1; k < n; k++ {
+			j0, j1 = j1, float64(2*k+1)/x*j1-j0
+		}
+		return j1
+	}
+	return sphericalbessel_miller(n, x, j0, j1, -1)
+}
+
+// SphericalBesselY returns the spherical Bessel function of the second kind, defined by
+//
+//	SphericalBesselY(n, x) = Sqrt(π/(2x)) BesselY(n+1/2, x)
+//
+// where BesselY is the Bessel function of the second kind. For n < 0,
+// SphericalBesselY(n, x) = (-1)**(n+1) SphericalBesselJ(-n-1, x).
+//
+// See http://mathworld.wolfram.com/SphericalBesselFunctionoftheSecondKind.html for more information.
+func SphericalBesselY(n int, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case n < 0:
+		return float64(powN1(n+1)) * SphericalBesselJ(-n-1, x)
+	case math.IsInf(x, 0):
+		return 0
+	case x == 0:
+		return math.Inf(-1)
+	case x < 0:
+		return float64(powN1(n+1)) * SphericalBesselY(n, -x)
+	}
+
+	// Forward recurrence is stable for all n.
+	sin, cos := math.Sincos(x)
+	y0 := -cos / x
+	if n == 0 {
+		return y0
+	}
+	y1 := (y0 - sin) / x
+	for k := 1; k < n && !math.IsInf(y1, 0); k++ {
+		y0, y1 = y1, float64(2*k+1)/x*y1-y0
+	}
+	return y1
+}
+
+// SphericalBesselI returns the modified spherical Bessel function of the first kind, defined by
+//
+//	SphericalBesselI(n, x) = Sqrt(π/(2x)) BesselI(n+1/2, x)
+//
+// where BesselI is the modified Bessel function of the first kind.
+//
+// See http://mathworld.wolfram.com/ModifiedSphericalBesselFunctionoftheFirstKind.html for more information.
+func SphericalBesselI(n int, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x):
+		return math.NaN()
+	case x == 0:
+		switch {
+		case n == 0:
+			return 1
+		case n > 0:
+			return 0
+		}
+		return math.Inf(1)
+	case x < 0:
+		return float64(powN1(n)) * SphericalBesselI(n, -x)
+	case math.IsInf(x, 1):
+		return x
+	case n < 0:
+		return math.Sqrt(math.Pi/(2*x)) * BesselI(float64(n)+0.5, x)
+	}
+
+	i0 := math.Sinh(x) / x
+	if n == 0 || math.IsInf(i0, 0) {
+		return i0
+	}
+	i1 := (math.Cosh(x) - i0) / x
+	return sphericalbessel_miller(n, x, i0, i1, 1)
+}
+
+// SphericalBesselK returns the modified spherical Bessel function of the second kind, defined by
+//
+//	SphericalBesselK(n, x) = Sqrt(π/(2x)) BesselK(n+1/2, x)
+//
+// where BesselK is the modified Bessel function of the second kind. SphericalBesselK
+// satisfies SphericalBesselK(-n-1, x) = SphericalBesselK(n, x) and is NaN for x < 0.
+//
+// See http://mathworld.wolfram.com/ModifiedSphericalBesselFunctionoftheSecondKind.html for more information.
+func SphericalBesselK(n int, x float64) float64 {
+	if n < 0 {
+		n = -n - 1
+	}
+
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || x < 0:
+		return math.NaN()
+	case math.IsInf(x, 1):
+		return 0
+	case x == 0:
+		return math.Inf(1)
+	}
+
+	// Forward recurrence is stable for all n.
+	k0 := math.Pi / 2 * math.Exp(-x) / x
+	if n == 0 {
+		return k0
+	}
+	k1 := k0 * (1 + 1/x)
+	for k := 1; k < n && !math.IsInf(k1, 0); k++ {
+		k0, k1 = k1, float64(2*k+1)/x*k1+k0
+	}
+	return k1
+}
+
+// sphericalbessel_miller returns the spherical Bessel function f(n, x) for n ≥ 1 and x > 0 using
+// Miller's algorithm, i.e. the recurrence relation
+//
+//	f(k-1, x) = (2k+1)/x f(k, x) + s f(k+1, x)
+//
+// applied downwards from a large starting order with arbitrary values, and normalised using
+// the exact values f0 = f(0, x) and f1 = f(1, x). The recurrence applies to SphericalBesselJ
+// with s = -1 and SphericalBesselI with s = 1, for which it is stable in the downward direction.
+func sphericalbessel_miller(n int, x, f0, f1, s float64) float64 {
+	const (
+		big  = 1e250
+		acc  = 160
+		nmin = 20
+	)
+
+	m := int(math.Max(float64(n), x)) + int(math.Sqrt(acc*math.Max(float64(n), x))) + nmin
+
+	fn := 0.0
+	fk, fk1 := 1.0, 0.0
+	for k := m; k > 0; k-- {
+		fk, fk1 = float64(2*k+1)/x*fk+s*fk1, fk
+		if math.Abs(fk) > big {
+			fk /= big
+			fk1 /= big
+			fn /= big
+		}
+		if k-1 == n {
+			fn = fk
+		}
+	}
+
+	// Normalise with whichever of f0, f1 is larger in magnitude, to avoid
+	// dividing by a value close to a zero of f(0, x).
+	if math.Abs(f0) >= math.Abs(f1) {
+		return f0 * (fn / fk)
+	}
+	return f1 * (fn / fk1)
+}
@@ -0,0 +1,166 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestSphericalBesselJ(t *testing.T) {
+	cases := []struct {
+		In1      int
+		In2, Out float64
+	}{
+		{0, nan, nan},
+		{3, +inf, 0},
+		{0, 0, 1},
+		{4, 0, 0},
+		{-1, 0, +inf},
+		{-1, 0.5, 1.7551651237807455},
+		{-2, 0.5, -4.469181324769897},
+		{5, -3.14, -0.019893011219035282},
+		{0, 0.001, 0.99999983333334164},
+		{0, 3.14, 0.0005072143046136792},
+		{0, 50, -0.005247497074078576},
+		{1, 0.5, 0.16253703063606656},
+		{1, 10, 0.078466941798751549},
+		{5, 0.001, 9.6200092500092561e-20},
+		{5, 3.14, 0.019893011219035282},
+		{10, 10, 0.064605154492564265},
+		{10, 29, 0.017095165365330629},
+		{30, 0.5, 5.2154726081997031e-52},
+		{30, 29, 0.019600932594074191},
+		{30, 50, -0.0014946734536051122},
+		{100, 3.14, 3.5919919720751994e-140},
+		{100, 50, 1.0190122629310462e-22},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := SphericalBesselJ(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestSphericalBesselY(t *testing.T) {
+	cases := []struct {
+		In1      int
+		In2, Out float64
+	}{
+		{0, nan, nan},
+		{3, -inf, 0},
+		{2, 0, -inf},
+		{-1, 0.5, 0.95885107720840601},
+		{-2, 0.5, -0.16253703063606656},
+		{1, -0.5, -4.469181324769897},
+		{0, -0.5, 1.7551651237807455},
+		{0, 0.001, -999.99950000004162},
+		{0, 3.14, 0.31847093367119095},
+		{0, 50, -0.019299320569842265},
+		{1, 0.5, -4.469181324769897},
+		{1, 10, 0.062792826379701502},
+		{5, 0.001, -9.4500005250000185e+20},
+		{5, 3.14, -1.8131933519860641},
+		{10, 10, -0.17245367208805784},
+		{10, 29, 0.031351666268169431},
+		{30, 0.5, -6.2873106616509016e+49},
+		{30, 29, -0.087170733113747595},
+		{30, 50, -0.022412268120502118},
+		{100, 3.14, -4.4131738403772954e+136},
+		{100, 50, -1.1256928913266162e+18},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := SphericalBesselY(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestSphericalBesselI(t *testing.T) {
+	cases := []struct {
+		In1      int
+		In2, Out float64
+	}{
+		{0, nan, nan},
+		{3, +inf, +inf},
+		{0, 0, 1},
+		{4, 0, 0},
+		{-1, 0, +inf},
+		{1, -0.5, -0.17087070843777213},
+		{0, 1000, +inf},
+		{0, 0.001, 1.0000001666666749},
+		{0, 3.14, 3.6720675256083148},
+		{0, 50, 5.1847055285870723e+19},
+		{1, 0.5, 0.17087070843777213},
+		{1, 10, 991.19096326329839},
+		{5, 0.001, 9.6200099900099963e-20},
+		{5, 3.14, 0.042518846745009262},
+		{10, 10, 5.4645416534307252},
+		{10, 29, 10053498689.005646},
+		{30, 0.5, 5.2362100466218908e-52},
+		{30, 29, 19087.525541542713},
+		{30, 50, 5676593928693482},
+		{100, 3.14, 3.7707592562737264e-140},
+		{100, 50, 2.3418937401088288e-17},
+		{1, 400, 6.5105200194246666e+170},
+		{5, 400, 6.2863213653135639e+170},
+		{30, 400, 2.0391479211007158e+170},
+		{1, 700, 7.2341653699976343e+300},
+		{10, 700, 6.6967238615007557e+300},
+		{100, 700, 5.370506718779588e+297},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := SphericalBesselI(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestSphericalBesselK(t *testing.T) {
+	cases := []struct {
+		In1      int
+		In2, Out float64
+	}{
+		{0, nan, nan},
+		{0, -1, nan},
+		{3, +inf, 0},
+		{2, 0, +inf},
+		{-1, 0.5, 1.9054722647301798},
+		{-6, 3.14, 0.92693350502853133},
+		{0, 0.001, 1569.2263156045312},
+		{0, 3.14, 0.021652375782743278},
+		{0, 50, 6.059346352975875e-24},
+		{1, 0.5, 5.7164167941905397},
+		{1, 10, 7.8445447198423253e-06},
+		{5, 0.001, 1.4844024463543731e+21},
+		{5, 3.14, 0.92693350502853133},
+		{10, 10, 0.00099076229144390597},
+		{10, 29, 8.7347098105549204e-14},
+		{30, 0.5, 9.834325212465363e+49},
+		{30, 29, 3.3711545744259682e-08},
+		{30, 50, 4.7246000572688119e-20},
+		{100, 3.14, 6.5971061900932578e+136},
+		{100, 50, 5975311343975.9414},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := SphericalBesselK(c.In1, c.In2)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
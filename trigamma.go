@@ -33,24 +33,28 @@ func Trigamma(x float64) float64 {
 
 	// For |x| > min, use an asymptotic (divergent) series expansion about x = ±∞.
 
-	const (
-		c0 = 1. / 6
-		c1 = -1. / 30
-		c2 = 1. / 42
-		c3 = -1. / 30
-		c4 = 5. / 66
-		c5 = -691. / 2730
-		c6 = 7. / 6
-		c7 = -3617. / 510
-	)
 	s := math.Copysign(1, x)
 	x = math.Abs(x)
 	y := 1 / (x * x)
 	xinv := 1 / x
-	res += s * xinv * (1 + s*xinv/2 + y*poly(y, c0, c1, c2, c3, c4, c5, c6, c7))
+	res += s * xinv * (1 + s*xinv/2 + y*poly(y, trigamma_asymptotic_coefficients...))
 	if s < 0 {
 		cot := 1 / math.Tan(math.Pi*x)
 		res += math.Pi * math.Pi * (1 + cot*cot)
 	}
 	return res
 }
+
+// The coefficients of the asymptotic series for Trigamma are
+// trigamma_asymptotic_coefficients[n] = B(2n+2)
+// where B(n) is the nth Bernoulli number.
+var trigamma_asymptotic_coefficients = []float64{
+	1. / 6,
+	-1. / 30,
+	1. / 42,
+	-1. / 30,
+	5. / 66,
+	-691. / 2730,
+	7. / 6,
+	-3617. / 510,
+}
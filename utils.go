@@ -49,6 +49,17 @@ func poly(x float64, c ...float64) float64 {
 
 }
 
+// cpoly evaluates a polynomial cs[0] + cs[1].z + ... + cs[n].z^n with real coefficients
+// at complex z using Horner's method.
+func cpoly(z complex128, c ...float64) complex128 {
+	n := len(c)
+	res := complex(c[n-1], 0)
+	for i := n - 2; i >= 0; i-- {
+		res = z*res + complex(c[i], 0)
+	}
+	return res
+}
+
 func isNegInt(x float64) bool {
 	if x < 0 {
 		_, xf := math.Modf(x)
@@ -60,3 +71,78 @@ func isNegInt(x float64) bool {
 func isNonPosInt(x float64) bool {
 	return x == 0 || isNegInt(x)
 }
+
+// sinPi returns Sin(π*x), which is exactly zero when x is an integer.
+func sinPi(x float64) float64 {
+	s := 1.0
+	if x < 0 {
+		x = -x
+		s = -1
+	}
+	x = math.Mod(x, 2)
+	if x > 1 {
+		x--
+		s = -s
+	}
+	if x > 0.5 {
+		x = 1 - x
+	}
+	if x == 0 {
+		return 0
+	}
+	return s * math.Sin(math.Pi*x)
+}
+
+// cosPi returns Cos(π*x), which is exactly zero when x is a half-integer.
+func cosPi(x float64) float64 {
+	x = math.Mod(math.Abs(x), 2)
+	s := 1.0
+	if x > 1 {
+		x = 2 - x
+	}
+	if x > 0.5 {
+		x = 1 - x
+		s = -1
+	}
+	if x == 0.5 {
+		return 0
+	}
+	return s * math.Cos(math.Pi*x)
+}
+
+// sinPiComplex returns Sin(π*z) for complex z, which is exactly real when z is real.
+func sinPiComplex(z complex128) complex128 {
+	x, y := real(z), imag(z)
+	return complex(sinPi(x)*math.Cosh(math.Pi*y), cosPi(x)*math.Sinh(math.Pi*y))
+}
+
+// cosPiComplex returns Cos(π*z) for complex z, which is exactly real when z is real.
+func cosPiComplex(z complex128) complex128 {
+	x, y := real(z), imag(z)
+	return complex(cosPi(x)*math.Cosh(math.Pi*y), -sinPi(x)*math.Sinh(math.Pi*y))
+}
+
+// alternatingSum returns the sum of the alternating series a(0) - a(1) + a(2) - ...,
+// accelerated with the algorithm of Cohen, Rodriguez Villegas and Zagier, using n terms.
+// The error is of order 5.8**(-n) when a(k) is a moment sequence, i.e. a(k) = ∫ dμ(w) w**k
+// for a positive measure μ on [0, 1].
+func alternatingSum(n int, a func(k int) float64) float64 {
+	d := math.Pow(3+2*math.Sqrt2, float64(n))
+	d = (d + 1/d) / 2
+	b, c := -1.0, -d
+	res := 0.0
+	for k := 0; k < n; k++ {
+		c = b - c
+		res += c * a(k)
+		fk, fn := float64(k), float64(n)
+		b *= (fk + fn) * (fk - fn) / ((fk + 0.5) * (fk + 1))
+	}
+	return res / d
+}
+
+// expx2 returns Exp(x*x), correcting for the rounding error in x*x.
+func expx2(x float64) float64 {
+	p := x * x
+	e := math.FMA(x, x, -p)
+	return math.Exp(p) * (1 + e)
+}
@@ -81,6 +81,27 @@ func TestPoly(t *testing.T) {
 	}
 }
 
+func TestAlternatingSum(t *testing.T) {
+	cases := []struct {
+		In  func(k int) float64
+		Out float64
+	}{
+		{func(k int) float64 { return 1 / float64(k+1) }, math.Ln2},
+		{func(k int) float64 { return 1 / float64(2*k+1) }, math.Pi / 4},
+		{func(k int) float64 { return math.Pow(0.5, float64(k)) }, 2. / 3},
+		{func(k int) float64 { return 1 / float64((k+1)*(k+1)) }, math.Pi * math.Pi / 12},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := alternatingSum(25, c.In)
+			ok := math.Abs(res-c.Out) < 1e-15
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
 func TestIsNegInt(t *testing.T) {
 	cases := []struct {
 		In1 float64
@@ -136,3 +157,56 @@ func TestIsNonPosInt(t *testing.T) {
 		})
 	}
 }
+
+func TestSinPi(t *testing.T) {
+	cases := []struct {
+		In1, Out float64
+	}{
+		{0, 0},
+		{1, 0},
+		{-7, 0},
+		{1e+20, 0},
+		{0.5, 1},
+		{-0.5, -1},
+		{1.5, -1},
+		{2.5, 1},
+		{0.25, math.Sqrt2 / 2},
+		{-2.75, -math.Sqrt2 / 2},
+		{1. / 6, 0.5},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := sinPi(c.In1)
+			ok := math.Abs(res-c.Out) < 1e-15
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestCosPi(t *testing.T) {
+	cases := []struct {
+		In1, Out float64
+	}{
+		{0, 1},
+		{1, -1},
+		{-7, -1},
+		{0.5, 0},
+		{-0.5, 0},
+		{1e+20, 1},
+		{2.5, 0},
+		{0.25, math.Sqrt2 / 2},
+		{-2.75, -math.Sqrt2 / 2},
+		{1. / 3, 0.5},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := cosPi(c.In1)
+			ok := math.Abs(res-c.Out) < 1e-15
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package special
+
+import "math"
+
+// Voigt returns the Voigt profile, which is the convolution of a normal distribution with
+// standard deviation sigma and a Cauchy distribution with half width at half maximum gamma,
+// defined by
+//
+//	                         ∞
+//	Voigt(x, sigma, gamma) = ∫ dt G(t, sigma) L(x - t, gamma)
+//	                        t=-∞
+//
+//	                       = Re[Faddeeva(z)] / [sigma Sqrt(2π)]
+//
+// where z = (x + i gamma) / (sigma Sqrt(2)), G(x, sigma) = Exp(-x**2/(2sigma**2)) / [sigma Sqrt(2π)]
+// and L(x, gamma) = gamma / [π (x**2 + gamma**2)]. Voigt reduces to G for gamma = 0 and to L
+// for sigma = 0.
+//
+// See http://mathworld.wolfram.com/VoigtFunction.html for more information.
+func Voigt(x, sigma, gamma float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsNaN(sigma) || math.IsNaN(gamma) || sigma < 0 || gamma < 0:
+		return math.NaN()
+	case math.IsInf(x, 0) || math.IsInf(sigma, 1) || math.IsInf(gamma, 1):
+		return 0
+	case sigma == 0 && gamma == 0:
+		if x == 0 {
+			return math.Inf(1)
+		}
+		return 0
+	case sigma == 0:
+		return gamma / math.Pi / (x*x + gamma*gamma)
+	}
+
+	s := sigma * math.Sqrt2
+	u, _ := faddeeva(x/s, gamma/s)
+	return u / (s * math.SqrtPi)
+}
@@ -0,0 +1,40 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestVoigt(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, Out float64
+	}{
+		{nan, 1, 1, nan},
+		{0, -1, 1, nan},
+		{0, 1, -1, nan},
+		{inf, 1, 1, 0},
+		{0, 0, 0, inf},
+		{1, 0, 0, 0},
+		{2, 0, 1, 0.063661977236758134},
+		{0, 1, 1, 0.20870928052036769},
+		{1, 1, 1, 0.16579566268916646},
+		{-2, 0.5, 0.1, 0.01052664750674449},
+		{3, 2, 0.01, 0.064859811354437746},
+		{10, 1, 1, 0.0032487348597690954},
+		{0.5, 0.1, 3, 0.10313634619408681},
+		{100, 1, 2, 6.3655605306718444e-05},
+		{5, 1, 0.001, 1.6201780373359587e-05},
+		{1.5, 1, 0, 0.12951759566589172},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := Voigt(c.In1, c.In2, c.In3)
+			ok := equalFloat64(res, c.Out)
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package special
+
+import "math"
+
+// WhittakerM returns the Whittaker function M, defined by
+//
+//	WhittakerM(κ, μ, x) = Exp(-x/2) x**(μ+1/2) 1F1(1/2+μ-κ; 1+2μ; x)
+//
+// for x ≥ 0 and 2μ ≠ -1, -2, -3, .... WhittakerM is a solution of Whittaker's equation,
+// d²w/dx² + (-1/4 + κ/x + (1/4-μ**2)/x**2) w = 0, which behaves like x**(μ+1/2) as x → 0.
+//
+// See http://mathworld.wolfram.com/WhittakerFunction.html for more information.
+func WhittakerM(kappa, mu, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(kappa) || math.IsNaN(mu) || math.IsNaN(x) || x < 0 || isNonPosInt(1+2*mu):
+		return math.NaN()
+	}
+
+	a := 0.5 + mu - kappa
+	b := 1 + 2*mu
+	return math.Exp((mu+0.5)*math.Log(x)-x/2) * HypPFQ([]float64{a}, []float64{b}, x)
+}
+
+// WhittakerW returns the Whittaker function W, defined by
+//
+//	WhittakerW(κ, μ, x) = Exp(-x/2) x**(μ+1/2) HypU(1/2+μ-κ, 1+2μ, x)
+//
+// for x ≥ 0. WhittakerW is the solution of Whittaker's equation,
+// d²w/dx² + (-1/4 + κ/x + (1/4-μ**2)/x**2) w = 0, with WhittakerW(κ, μ, x) ~ Exp(-x/2) x**κ
+// as x → ∞, and is even in μ.
+//
+// See http://mathworld.wolfram.com/WhittakerFunction.html for more information.
+func WhittakerW(kappa, mu, x float64) float64 {
+	// Special cases.
+	switch {
+	case math.IsNaN(kappa) || math.IsNaN(mu) || math.IsNaN(x) || x < 0:
+		return math.NaN()
+	case math.IsInf(x, 1):
+		return 0
+	case x == 0:
+		mu = math.Abs(mu)
+		switch {
+		case mu < 0.5:
+			return 0
+		case mu == 0.5:
+			return GammaRatio([]float64{}, []float64{1 - kappa})
+		}
+		return math.Copysign(math.Inf(1), float64(GammaSign(0.5+mu-kappa)))
+	}
+
+	a := 0.5 + mu - kappa
+	b := 1 + 2*mu
+	return math.Exp((mu+0.5)*math.Log(x)-x/2) * HypU(a, b, x)
+}
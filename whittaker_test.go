@@ -0,0 +1,63 @@
+package special_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestWhittakerM(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, Out float64
+	}{
+		{nan, 0.5, 1, nan},
+		{0.5, nan, 1, nan},
+		{0.5, 0.5, nan, nan},
+		{0.5, 0.5, -1, nan},
+		{0.5, -1, 1, nan},
+		{0.5, 0.25, 0, 0},
+		{0, 0.5, 3, 4.258558910189634},
+		{0.5, 0.25, 2, 0.9948565892668343},
+		{-1.3, 1.7, 0.4, 0.1502723430652387},
+		{2.5, -0.3, 6, 1.740413731968841},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := WhittakerM(c.In1, c.In2, c.In3)
+			if !equalFloat64(res, c.Out) {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
+
+func TestWhittakerW(t *testing.T) {
+	cases := []struct {
+		In1, In2, In3, Out float64
+	}{
+		{nan, 0.5, 1, nan},
+		{0.5, nan, 1, nan},
+		{0.5, 0.5, nan, nan},
+		{0.5, 0.5, -1, nan},
+		{0.5, 0.5, inf, 0},
+		{0.5, 0.25, 0, 0},
+		{0.5, -0.5, 0, 0.5641895835477563},
+		{0.5, 2, 0, inf},
+		{0.5, 0.25, 2, 0.534013946067451},
+		{0.5, -0.25, 2, 0.534013946067451},
+		{-1.3, 1.7, 0.4, 2.1729326418137607},
+		{2.5, -0.3, 6, 1.747297907439013},
+		{1, 0.5, 45, 7.6135406517680865e-09},
+		{0.3, 0, 1.5, 0.5229707658368618},
+		{-0.7, 2, 0.1, 76.43373905894254},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := WhittakerW(c.In1, c.In2, c.In3)
+			if !equalFloat64(res, c.Out) {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}
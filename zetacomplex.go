@@ -0,0 +1,121 @@
+package special
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// ZetaComplex returns the Riemann zeta function for complex s, defined by
+//
+//	                 ∞
+//	ZetaComplex(s) = ∑ 1 / n**s,   Re(s) > 1
+//	                n=1
+//
+// and by analytic continuation elsewhere, using the functional equation
+//
+//	ZetaComplex(s) = 2**s π**(s-1) Sin(πs/2) GammaComplex(1-s) ZetaComplex(1-s)
+//
+// ZetaComplex has a simple pole at s = 1.
+//
+// See http://mathworld.wolfram.com/RiemannZetaFunction.html for more information.
+func ZetaComplex(s complex128) complex128 {
+	x, y := real(s), imag(s)
+
+	// Special cases.
+	switch {
+	case math.IsNaN(x) || math.IsNaN(y) || math.IsInf(y, 0) || math.IsInf(x, -1):
+		return cmplx.NaN()
+	case math.IsInf(x, 1):
+		return 1
+	case y == 0:
+		return complex(Zeta(x), 0)
+	}
+
+	switch {
+	case x == 0.5 && math.Abs(y) >= riemann_siegel_tmin:
+		// Riemann-Siegel formula on the critical line.
+		return cmplx.Rect(riemann_siegel_z(math.Abs(y)), -RiemannSiegelTheta(y))
+	case x < 0:
+		// Functional equation, with the logarithm of Sin(πs/2) evaluated asymptotically far
+		// from the real axis, where Sin(πs/2) ~ ±(i/2) Exp(∓iπs/2) for Im(s) ≷ 0.
+		var lsin complex128
+		switch {
+		case y > 40:
+			lsin = complex(-math.Ln2, math.Pi/2) - complex(0, math.Pi/2)*s
+		case y < -40:
+			lsin = complex(-math.Ln2, -math.Pi/2) + complex(0, math.Pi/2)*s
+		default:
+			lsin = cmplx.Log(sinPiComplex(s / 2))
+		}
+		l := s*math.Ln2 + (s-1)*complex(math.Log(math.Pi), 0) + LgammaComplex(1-s) + lsin
+		return cmplx.Exp(l) * zeta_euler_maclaurin(1-s)
+	}
+	return zeta_euler_maclaurin(s)
+}
+
+// zeta_euler_maclaurin returns ZetaComplex(s) for Re(s) ≥ 0 and s ≠ 1 using the
+// Euler-Maclaurin summation formula
+//
+//	                 N-1
+//	ZetaComplex(s) =  ∑ n**(-s) + N**(1-s) / (s-1) + N**(-s) / 2 + ∑ T[k]
+//	                 n=1                                           k
+//
+// where T[k] = B(2k) / (2k)! s(s+1)...(s+2k-2) N**(-s-2k+1) and B(n) is the nth Bernoulli
+// number. N is chosen proportional to |s|, so that the T[k] decrease geometrically.
+func zeta_euler_maclaurin(s complex128) complex128 {
+	const tol = 1e-17
+
+	n := 20 + math.Floor(cmplx.Abs(s)/math.Pi)
+
+	var res complex128
+	for k := 1.0; k < n; k++ {
+		res += zeta_pow(k, s)
+	}
+	ns := zeta_pow(n, s)
+	res += ns*complex(n, 0)/(s-1) + ns/2
+
+	// tmp = s(s+1)...(s+2k-2) N**(-s-2k+1).
+	tmp := s * ns / complex(n, 0)
+	for k, b := range bernoulli_coefficients {
+		term := complex(b, 0) * tmp
+		res += term
+		if cmplx.Abs(term) < tol*cmplx.Abs(res) {
+			break
+		}
+		fk := float64(2*k + 2)
+		tmp *= (s + complex(fk-1, 0)) * (s + complex(fk, 0)) / complex(n*n, 0)
+	}
+	return res
+}
+
+// zeta_pow returns n**(-s) for n > 0.
+func zeta_pow(n float64, s complex128) complex128 {
+	l := math.Log(n)
+	return cmplx.Rect(math.Exp(-real(s)*l), -imag(s)*l)
+}
+
+// The coefficients of the Euler-Maclaurin summation formula are
+// bernoulli_coefficients[k] = B(2k+2) / (2k+2)!
+// where B(n) is the nth Bernoulli number.
+var bernoulli_coefficients = []float64{
+	1. / 12,
+	-1. / 720,
+	1. / 30240,
+	-1. / 1209600,
+	1. / 47900160,
+	-691. / 1307674368000,
+	1. / 74724249600,
+	-3617. / 10670622842880000,
+	43867. / 5109094217170944000,
+	-174611. / 802857662698291200000,
+	77683. / 14101100039391805440000,
+	-236364091. / 1693824136731743669452800000,
+	657931. / 186134520519971831808000000,
+	-3392780147. / 37893265687455865519472640000000,
+	1723168255201. / 759790291646040068357842010112000000,
+	-7709321041217. / 134196726836183700385281186201600000000,
+	151628697551. / 104199811425742637946218332815360000000,
+	-26315271553053477373. / 713925872841910517552409860896601407488000000000,
+	154210205991661. / 165165037094716140555791754978970828800000000,
+	-261082718496449122051. / 11039333782344056345696120477635448049500160000000000,
+}
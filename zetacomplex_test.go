@@ -0,0 +1,46 @@
+package special_test
+
+import (
+	"fmt"
+	"math/cmplx"
+	"testing"
+
+	. "github.com/scientificgo/special"
+)
+
+func TestZetaComplex(t *testing.T) {
+	cases := []struct {
+		In, Out complex128
+	}{
+		{cmplx.NaN(), cmplx.NaN()},
+		{complex(inf, 1), 1},
+		{complex(1, inf), cmplx.NaN()},
+		{complex(1, 0), complex(inf, 0)},
+		{complex(-1, 0), complex(-1./12, 0)},
+		{complex(2, 3), complex(0.7980219851462758, -0.1137443080529385)},
+		{complex(0.5, 14.134725141734695), 0},
+		{complex(0.5, 100), complex(2.692619885681324, -0.020386029602598162)},
+		{complex(0.3, 50), complex(-0.4779701683660468, 0.30179894143408387)},
+		{complex(0, 5), complex(0.6330785840367499, 0.2906598997169494)},
+		{complex(-5, 10), complex(4.425977776893547, 16.213350107031268)},
+		{complex(-2, 0.001), complex(3.2881753992904495e-08, -3.0448443189654586e-05)},
+		{complex(-20, 3), complex(-1525.306580059449, -3443.4666611869097)},
+		{complex(-3, -60), complex(1661.8639025166422, -1922.168898637726)},
+		{complex(10, 1), complex(1.0007590778298567, -0.000640128026785524)},
+		{complex(0.5, -999), complex(0.5880316758530681, -0.40117963087992975)},
+		{complex(1.5, 3000), complex(1.2300449836566552, 0.25421573565208494)},
+		{complex(1, 1e-08), complex(0.5772156649015329, -100000000.0)},
+		{complex(0.5, 5000.234316931328), 0},
+		{complex(0.5, 5000), complex(0.40684271363543256, -0.6937641591980851)},
+		{complex(-0.5, 80), complex(-12.465044513021919, 9.950701392392924)},
+	}
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("%v", i), func(tt *testing.T) {
+			res := ZetaComplex(c.In)
+			ok := equalFloat64(real(res), real(c.Out)) && equalFloat64(imag(res), imag(c.Out))
+			if !ok {
+				tt.Errorf("Got %v, want %v", res, c.Out)
+			}
+		})
+	}
+}